@@ -430,6 +430,14 @@ func ECSTaskDefinitionCreate(req Request) (string, map[string]string, error) {
 			Privileged: aws.Bool(privileged),
 		}
 
+		if u, ok := task["User"].(string); ok && u != "" {
+			r.ContainerDefinitions[i].User = aws.String(u)
+		}
+
+		if wd, ok := task["WorkingDirectory"].(string); ok && wd != "" {
+			r.ContainerDefinitions[i].WorkingDirectory = aws.String(wd)
+		}
+
 		// set Command from either -
 		// a single string (shell form) - ["sh", "-c", command]
 		// an array of strings (exec form) - ["cmd1", "cmd2"]
@@ -11,6 +11,7 @@ func main() {
 	go workers.StartCluster()
 	go workers.StartHeartbeat()
 	go workers.StartServicesCapacity()
+	go workers.StartServicesHealth()
 
 	for {
 		time.Sleep(1 * time.Hour)
@@ -121,7 +121,7 @@ func BuildCreate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	if source != nil {
 		b, err = provider.BuildCreateTar(app, source, r.FormValue("manifest"), r.FormValue("description"), cache)
 	} else if repo != "" {
-		b, err = provider.BuildCreateRepo(app, repo, r.FormValue("manifest"), r.FormValue("description"), cache)
+		b, err = provider.BuildCreateRepo(app, repo, r.FormValue("ref"), r.FormValue("dir"), r.FormValue("manifest"), r.FormValue("description"), cache)
 	} else if index != "" {
 		var i structs.Index
 		err := json.Unmarshal([]byte(index), &i)
@@ -152,6 +152,32 @@ func BuildUpdate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 		return httperr.Server(err)
 	}
 
+	if a := r.FormValue("author"); a != "" {
+		b.Author = a
+	}
+
+	if br := r.FormValue("branch"); br != "" {
+		b.Branch = br
+	}
+
+	if v := r.FormValue("builder-version"); v != "" {
+		b.BuilderVersion = v
+	}
+
+	if c := r.FormValue("commit"); c != "" {
+		b.Commit = c
+	}
+
+	if i := r.FormValue("images"); i != "" {
+		images := map[string]structs.BuildImage{}
+
+		if err := json.Unmarshal([]byte(i), &images); err != nil {
+			return httperr.Errorf(403, "invalid images: %s", err)
+		}
+
+		b.Images = images
+	}
+
 	if d := r.FormValue("description"); d != "" {
 		b.Description = d
 	}
@@ -211,6 +237,11 @@ func BuildUpdate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 		}
 	}
 
+	// builds deleted above release their index blobs
+	if didComplete {
+		go collectIndex()
+	}
+
 	if b.Status == "failed" {
 		provider.EventSend(&structs.Event{
 			Action: "build:create",
@@ -238,6 +269,78 @@ func BuildCopy(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	return RenderJson(rw, b)
 }
 
+func BuildCredentialsGet(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	app := mux.Vars(r)["app"]
+
+	creds, err := provider.BuildCredentialsGet(app)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such app: %s", app)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, redactBuildCredentials(creds))
+}
+
+func BuildCredentialsSet(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	app := mux.Vars(r)["app"]
+
+	creds, err := provider.BuildCredentialsGet(app)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such app: %s", app)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	if key := r.FormValue("ssh-key"); key != "" {
+		creds.SSHKey = key
+	}
+
+	if token := r.FormValue("token"); token != "" {
+		creds.Token = token
+	}
+
+	err = provider.BuildCredentialsSave(app, creds)
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, redactBuildCredentials(creds))
+}
+
+func BuildCredentialsDelete(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	app := mux.Vars(r)["app"]
+
+	creds := &structs.BuildCredentials{}
+
+	err := provider.BuildCredentialsSave(app, creds)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such app: %s", app)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, creds)
+}
+
+// redactBuildCredentials hides credential values, only showing which are set
+func redactBuildCredentials(creds *structs.BuildCredentials) *structs.BuildCredentials {
+	redacted := &structs.BuildCredentials{}
+
+	if creds.SSHKey != "" {
+		redacted.SSHKey = "[redacted]"
+	}
+
+	if creds.Token != "" {
+		redacted.Token = "[redacted]"
+	}
+
+	return redacted
+}
+
 func BuildLogs(ws *websocket.Conn) *httperr.Error {
 	vars := mux.Vars(ws.Request())
 
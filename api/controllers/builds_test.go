@@ -40,3 +40,56 @@ func TestBuildDelete(t *testing.T) {
 		assert.Equal(t, "build-id", resp.Id)
 	}
 }
+
+func TestBuildSecretsDelete(t *testing.T) {
+	testProvider := &provider.TestProviderRunner{
+		BuildSecrets: structs.Environment{
+			"NPM_TOKEN": "secret",
+			"GEM_TOKEN": "secret",
+		},
+	}
+	provider.CurrentProvider = testProvider
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	testProvider.On("BuildSecretsGet", "app-name").Return(testProvider.BuildSecrets, nil)
+	testProvider.On("BuildSecretsSave", "app-name", structs.Environment{"GEM_TOKEN": "secret"}).Return(nil)
+
+	body := test.HTTPBody("DELETE", "http://convox/apps/app-name/build-secrets/NPM_TOKEN", nil)
+
+	testProvider.AssertExpectations(t)
+
+	resp := structs.Environment{}
+	err := json.Unmarshal([]byte(body), &resp)
+	if assert.Nil(t, err) {
+		assert.Equal(t, structs.Environment{"GEM_TOKEN": "[redacted]"}, resp)
+	}
+}
+
+func TestBuildCredentialsGetRedacted(t *testing.T) {
+	testProvider := &provider.TestProviderRunner{
+		BuildCreds: structs.BuildCredentials{
+			Token: "secret-token",
+		},
+	}
+	provider.CurrentProvider = testProvider
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	testProvider.On("BuildCredentialsGet", "app-name").Return(&testProvider.BuildCreds, nil)
+
+	body := test.HTTPBody("GET", "http://convox/apps/app-name/build-credentials", nil)
+
+	testProvider.AssertExpectations(t)
+
+	assert.NotContains(t, body, "secret-token")
+
+	resp := structs.BuildCredentials{}
+	err := json.Unmarshal([]byte(body), &resp)
+	if assert.Nil(t, err) {
+		assert.Equal(t, "", resp.SSHKey)
+		assert.Equal(t, "[redacted]", resp.Token)
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/convox/rack/api/httperr"
 	"github.com/convox/rack/api/models"
+	"github.com/convox/rack/api/provider"
+	"github.com/convox/rack/api/structs"
 	"github.com/gorilla/mux"
 )
 
@@ -92,3 +94,79 @@ func EnvironmentDelete(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 
 	return RenderJson(rw, env)
 }
+
+func BuildSecretsList(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	app := mux.Vars(r)["app"]
+
+	secrets, err := provider.BuildSecretsGet(app)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such app: %s", app)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, redactBuildSecrets(secrets))
+}
+
+func BuildSecretsSet(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	app := mux.Vars(r)["app"]
+
+	secrets, err := provider.BuildSecretsGet(app)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such app: %s", app)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	for key, value := range structs.LoadEnvironment(body) {
+		secrets[key] = value
+	}
+
+	err = provider.BuildSecretsSave(app, secrets)
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, redactBuildSecrets(secrets))
+}
+
+func BuildSecretsDelete(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	vars := mux.Vars(r)
+	app := vars["app"]
+	name := vars["name"]
+
+	secrets, err := provider.BuildSecretsGet(app)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such app: %s", app)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	delete(secrets, name)
+
+	err = provider.BuildSecretsSave(app, secrets)
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, redactBuildSecrets(secrets))
+}
+
+// redactBuildSecrets hides secret values, only showing which are set
+func redactBuildSecrets(secrets structs.Environment) structs.Environment {
+	redacted := structs.Environment{}
+
+	for key := range secrets {
+		redacted[key] = "[redacted]"
+	}
+
+	return redacted
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/convox/rack/api/httperr"
 	"github.com/convox/rack/api/provider"
@@ -30,6 +32,23 @@ func IndexDiff(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	return RenderJson(rw, missing)
 }
 
+// IndexGCInterval limits how often unreferenced index blobs are collected
+var IndexGCInterval = 1 * time.Hour
+
+var (
+	indexGCLast time.Time
+	indexGCLock sync.Mutex
+)
+
+func IndexStats(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	stats, err := provider.IndexStats()
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, stats)
+}
+
 func IndexUpload(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	hash := mux.Vars(r)["hash"]
 
@@ -56,3 +75,23 @@ func IndexUpload(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 
 	return RenderSuccess(rw)
 }
+
+// collectIndex deletes index blobs no longer referenced by any build
+func collectIndex() {
+	indexGCLock.Lock()
+	defer indexGCLock.Unlock()
+
+	if time.Since(indexGCLast) < IndexGCInterval {
+		return
+	}
+
+	indexGCLast = time.Now()
+
+	count, err := provider.IndexGC()
+	if err != nil {
+		fmt.Printf("index gc error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("index gc deleted=%d\n", count)
+}
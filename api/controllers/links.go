@@ -8,6 +8,20 @@ import (
 	"github.com/gorilla/mux"
 )
 
+func LinkList(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	app := mux.Vars(r)["app"]
+
+	links, err := provider.LinkList(app)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such app: %s", app)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, links)
+}
+
 func LinkCreate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	service := mux.Vars(r)["service"]
 
@@ -22,7 +36,7 @@ func LinkCreate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 		return httperr.Errorf(403, "can not link service with status: %s", s.Status)
 	}
 
-	s, err = provider.ServiceLink(service, GetForm(r, "app"), GetForm(r, "process"))
+	s, err = provider.ServiceLink(service, GetForm(r, "app"), GetForm(r, "process"), GetForm(r, "as"))
 	if err != nil {
 		return httperr.Server(err)
 	}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/convox/rack/api/httperr"
+	"github.com/convox/rack/api/models"
+	"github.com/gorilla/mux"
+)
+
+// ManifestRender renders the template and parameters a manifest would apply to an app
+// when released from the app's current build
+func ManifestRender(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	app := mux.Vars(r)["app"]
+
+	a, err := models.GetApp(app)
+
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such app: %s", app)
+	}
+
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	manifest := GetForm(r, "manifest")
+
+	if manifest == "" {
+		return httperr.Errorf(403, "must specify a manifest")
+	}
+
+	if a.Release == "" {
+		return httperr.Errorf(403, "app %s has no releases to render against", app)
+	}
+
+	current, err := models.GetRelease(app, a.Release)
+
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	release := *current
+	release.Manifest = manifest
+
+	render, err := release.Render()
+
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, render)
+}
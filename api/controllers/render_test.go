@@ -0,0 +1,22 @@
+package controllers_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/convox/rack/test"
+)
+
+func TestManifestRenderWithAppNotFound(t *testing.T) {
+	// a stack name no other test describes, so a cached stack can't answer for it
+	aws := test.StubAws(
+		test.DescribeStackNotFound("convox-test-norender"),
+		test.DescribeStackNotFound("norender"),
+	)
+	defer aws.Close()
+
+	v := url.Values{}
+	v.Add("manifest", "web:\n  image: httpd\n")
+
+	test.AssertStatus(t, 404, "POST", "http://convox/apps/norender/render", v)
+}
@@ -24,11 +24,18 @@ func NewRouter() (router *mux.Router) {
 	router.HandleFunc("/apps/{app}/builds/{build}", api("build.update", BuildUpdate)).Methods("PUT")
 	router.HandleFunc("/apps/{app}/builds/{build}", api("build.delete", BuildDelete)).Methods("DELETE")
 	router.HandleFunc("/apps/{app}/builds/{build}/copy", api("build.copy", BuildCopy)).Methods("POST")
+	router.HandleFunc("/apps/{app}/build-credentials", api("build.credentials.get", BuildCredentialsGet)).Methods("GET")
+	router.HandleFunc("/apps/{app}/build-credentials", api("build.credentials.set", BuildCredentialsSet)).Methods("POST")
+	router.HandleFunc("/apps/{app}/build-credentials", api("build.credentials.delete", BuildCredentialsDelete)).Methods("DELETE")
+	router.HandleFunc("/apps/{app}/build-secrets", api("build.secrets.list", BuildSecretsList)).Methods("GET")
+	router.HandleFunc("/apps/{app}/build-secrets", api("build.secrets.set", BuildSecretsSet)).Methods("POST")
+	router.HandleFunc("/apps/{app}/build-secrets/{name}", api("build.secrets.delete", BuildSecretsDelete)).Methods("DELETE")
 	router.HandleFunc("/apps/{app}/environment", api("environment.list", EnvironmentList)).Methods("GET")
 	router.HandleFunc("/apps/{app}/environment", api("environment.set", EnvironmentSet)).Methods("POST")
 	router.HandleFunc("/apps/{app}/environment/{name}", api("environment.delete", EnvironmentDelete)).Methods("DELETE")
 	router.HandleFunc("/apps/{app}/formation", api("formation.list", FormationList)).Methods("GET")
 	router.HandleFunc("/apps/{app}/formation/{process}", api("formation.set", FormationSet)).Methods("POST")
+	router.HandleFunc("/apps/{app}/links", api("link.list", LinkList)).Methods("GET")
 	router.HandleFunc("/apps/{app}/parameters", api("parameters.list", ParametersList)).Methods("GET")
 	router.HandleFunc("/apps/{app}/parameters", api("parameters.set", ParametersSet)).Methods("POST")
 	router.HandleFunc("/apps/{app}/processes", api("process.list", ProcessList)).Methods("GET")
@@ -38,6 +45,7 @@ func NewRouter() (router *mux.Router) {
 	router.HandleFunc("/apps/{app}/releases", api("release.list", ReleaseList)).Methods("GET")
 	router.HandleFunc("/apps/{app}/releases/{release}", api("release.get", ReleaseGet)).Methods("GET")
 	router.HandleFunc("/apps/{app}/releases/{release}/promote", api("release.promote", ReleasePromote)).Methods("POST")
+	router.HandleFunc("/apps/{app}/render", api("manifest.render", ManifestRender)).Methods("POST")
 	router.HandleFunc("/apps/{app}/ssl", api("ssl.list", SSLList)).Methods("GET")
 	router.HandleFunc("/apps/{app}/ssl/{process}/{port}", api("ssl.update", SSLUpdate)).Methods("PUT")
 	router.HandleFunc("/auth", api("auth", Auth)).Methods("GET")
@@ -47,6 +55,7 @@ func NewRouter() (router *mux.Router) {
 	router.HandleFunc("/certificates/{id}", api("certificate.delete", CertificateDelete)).Methods("DELETE")
 	router.HandleFunc("/index/diff", api("index.diff", IndexDiff)).Methods("POST")
 	router.HandleFunc("/index/file/{hash}", api("index.upload", IndexUpload)).Methods("POST")
+	router.HandleFunc("/index/stats", api("index.stats", IndexStats)).Methods("GET")
 	router.HandleFunc("/instances", api("instances.get", InstancesList)).Methods("GET")
 	router.HandleFunc("/instances/{id}", api("instance.delete", InstanceTerminate)).Methods("DELETE")
 	router.HandleFunc("/instances/keyroll", api("instances.keyroll", InstancesKeyroll)).Methods("POST")
@@ -57,16 +66,24 @@ func NewRouter() (router *mux.Router) {
 	router.HandleFunc("/registries", api("registry.delete", RegistryDelete)).Methods("DELETE")
 	router.HandleFunc("/services", api("service.list", ServiceList)).Methods("GET")
 	router.HandleFunc("/services", api("service.create", ServiceCreate)).Methods("POST")
+	router.HandleFunc("/services/types", api("service.type.list", ServiceTypeList)).Methods("GET")
 	router.HandleFunc("/services/{service}", api("service.show", ServiceShow)).Methods("GET")
 	router.HandleFunc("/services/{service}", api("service.update", ServiceUpdate)).Methods("PUT")
 	router.HandleFunc("/services/{service}", api("service.delete", ServiceDelete)).Methods("DELETE")
+	router.HandleFunc("/services/{service}/backups", api("service.backup.list", ServiceBackupList)).Methods("GET")
+	router.HandleFunc("/services/{service}/backups", api("service.backup.create", ServiceBackupCreate)).Methods("POST")
+	router.HandleFunc("/services/{service}/backups/{backup}/clone", api("service.backup.clone", ServiceBackupClone)).Methods("POST")
+	router.HandleFunc("/services/{service}/backups/{backup}/restore", api("service.backup.restore", ServiceBackupRestore)).Methods("POST")
+	router.HandleFunc("/services/{service}/dependents", api("service.dependents", ServiceDependents)).Methods("GET")
 	router.HandleFunc("/services/{service}/links", api("link.create", LinkCreate)).Methods("POST")
 	router.HandleFunc("/services/{service}/links/{app}", api("link.delete", LinkDelete)).Methods("DELETE")
+	router.HandleFunc("/services/{service}/rotate-credentials", api("service.rotate-credentials", ServiceRotateCredentials)).Methods("POST")
 	router.HandleFunc("/sns", SNSProxy).Methods("POST").Headers("X-Amz-Sns-Message-Type", "Notification")
 	router.HandleFunc("/sns", SNSConfirm).Methods("POST").Headers("X-Amz-Sns-Message-Type", "SubscriptionConfirmation")
 	router.HandleFunc("/system", api("system.show", SystemShow)).Methods("GET")
 	router.HandleFunc("/system", api("system.update", SystemUpdate)).Methods("PUT")
 	router.HandleFunc("/system/capacity", api("system.capacity", SystemCapacity)).Methods("GET")
+	router.HandleFunc("/system/graph", api("system.graph", SystemGraph)).Methods("GET")
 	router.HandleFunc("/system/releases", api("system.release.list", SystemReleaseList)).Methods("GET")
 	router.HandleFunc("/switch", api("switch", Switch)).Methods("POST")
 
@@ -75,6 +92,8 @@ func NewRouter() (router *mux.Router) {
 	router.Handle("/apps/{app}/builds/{build}/logs", ws("build.logs", BuildLogs)).Methods("GET")
 	router.Handle("/apps/{app}/processes/{pid}/exec", ws("process.exec.attach", ProcessExecAttached)).Methods("GET")
 	router.Handle("/apps/{app}/processes/{process}/run", ws("process.run.attach", ProcessRunAttached)).Methods("GET")
+	router.Handle("/services/{service}/export", ws("service.export", ServiceExport)).Methods("GET")
+	router.Handle("/services/{service}/import", ws("service.import", ServiceImport)).Methods("GET")
 	router.Handle("/instances/{id}/ssh", ws("instance.ssh", InstanceSSH)).Methods("GET")
 	router.Handle("/proxy/{host}/{port}", ws("proxy", Proxy)).Methods("GET")
 
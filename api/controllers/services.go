@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -8,9 +9,17 @@ import (
 	"github.com/convox/rack/api/httperr"
 	"github.com/convox/rack/api/models"
 	"github.com/convox/rack/api/provider"
+	"github.com/convox/rack/api/structs"
+	"github.com/convox/rack/client"
+	"github.com/ddollar/logger"
 	"github.com/gorilla/mux"
+	"golang.org/x/net/websocket"
 )
 
+func ServiceTypeList(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	return RenderJson(rw, structs.ListServiceTypes())
+}
+
 func ServiceList(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	services, err := models.ListServices()
 
@@ -18,6 +27,10 @@ func ServiceList(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 		return httperr.Server(err)
 	}
 
+	if err := services.LoadLinks(); err != nil {
+		return httperr.Server(err)
+	}
+
 	return RenderJson(rw, services)
 }
 
@@ -32,16 +45,27 @@ func ServiceShow(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 		return httperr.Server(err)
 	}
 
+	// a service without its latest health is still shown
+	health, err := provider.ServiceHealthGet(service)
+	if err != nil {
+		logger.New("ns=kernel").At("ServiceShow").Log("service=%s err=%q", service, err)
+		health = nil
+	}
+
 	// new services should use the provider interfaces
-	if s.Type == "syslog" {
+	if t, err := structs.GetServiceType(s.Type); err == nil && t.Provider {
 		s, err := provider.ServiceGet(service)
 		if err != nil {
 			return httperr.Server(err)
 		}
 
+		s.Health = health
+
 		return RenderJson(rw, s)
 	}
 
+	s.Health = (*client.ServiceHealth)(health)
+
 	return RenderJson(rw, s)
 }
 
@@ -63,8 +87,17 @@ func ServiceCreate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	kind := params["type"]
 	delete(params, "type")
 
+	t, err := structs.GetServiceType(kind)
+	if err != nil {
+		return httperr.New(403, err)
+	}
+
+	if err := t.Validate(params); err != nil {
+		return httperr.New(403, err)
+	}
+
 	// new services should use the provider interfaces
-	if kind == "syslog" || kind == "papertrail" {
+	if t.Provider {
 		s, err := provider.ServiceCreate(name, kind, params)
 		if err != nil {
 			return httperr.Server(err)
@@ -118,6 +151,59 @@ func ServiceDelete(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 		return httperr.Server(err)
 	}
 
+	force := r.URL.Query().Get("force") == "true"
+
+	// apps linked to the service would be left with a URL to nothing
+	if !force {
+		ms, err := models.GetService(service)
+		if err != nil {
+			return httperr.Server(err)
+		}
+
+		links, err := ms.Dependents()
+		if err != nil {
+			return httperr.Server(err)
+		}
+
+		if len(links) > 0 {
+			apps := []string{}
+
+			for i, l := range links {
+				// links are sorted by app, one app can be linked for several processes
+				if i == 0 || links[i-1].App != l.App {
+					apps = append(apps, l.App)
+				}
+			}
+
+			return httperr.Errorf(403, "%s is linked to %s. Unlink it first or delete with --force", service, strings.Join(apps, ", "))
+		}
+	}
+
+	// databases snapshot their data when deleted, unless created before backups were
+	// supported
+	if t, err := structs.GetServiceType(s.Type); err == nil && t.Backups && !force {
+		ms, err := models.GetService(service)
+		if err != nil {
+			return httperr.Server(err)
+		}
+
+		final, err := ms.FinalSnapshot()
+		if err != nil {
+			return httperr.Server(err)
+		}
+
+		if !final {
+			return httperr.Errorf(403, "%s has no final snapshot and its data would be lost. Run `convox services backup %s` and delete with --force", service, service)
+		}
+	}
+
+	// forced deletes unlink the apps so their releases no longer point at the service
+	if force {
+		if err := models.UnlinkDependents(service); err != nil {
+			return httperr.Server(err)
+		}
+	}
+
 	s, err = provider.ServiceDelete(service)
 	if err != nil {
 		return httperr.Server(err)
@@ -126,6 +212,25 @@ func ServiceDelete(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	return RenderJson(rw, s)
 }
 
+func ServiceDependents(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	service := mux.Vars(r)["service"]
+
+	s, err := models.GetService(service)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such service: %s", service)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	links, err := s.Dependents()
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, links)
+}
+
 func ServiceUpdate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	service := mux.Vars(r)["service"]
 
@@ -150,7 +255,41 @@ func ServiceUpdate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 		params[key] = val
 	}
 
-	err = s.Update(models.CFParams(params))
+	dryRun := params["dry-run"] == "true"
+	confirm := params["confirm"] == "true"
+
+	delete(params, "dry-run")
+	delete(params, "confirm")
+
+	if t, err := structs.GetServiceType(s.Type); err == nil {
+		if err := t.ValidateChanges(params); err != nil {
+			return httperr.New(403, err)
+		}
+	}
+
+	changes := models.CFParams(params)
+
+	// updates that would replace a database or other stateful resource need confirmation
+	if dryRun || !confirm {
+		cs, err := s.Preview(changes)
+		if err != nil && awsError(err) == "ValidationError" {
+			e := err.(awserr.Error)
+			return httperr.Errorf(403, "%s", convoxifyCloudformationError(e.Message()))
+		}
+		if err != nil {
+			return httperr.Server(err)
+		}
+
+		if dryRun {
+			return RenderJson(rw, cs)
+		}
+
+		if rs := cs.Replacing(); len(rs) > 0 {
+			return httperr.Errorf(403, "this update replaces %s and the data in it is lost. Preview with --dry-run and update with --confirm", strings.Join(rs, ", "))
+		}
+	}
+
+	err = s.Update(changes)
 	if err != nil && awsError(err) == "ValidationError" {
 		e := err.(awserr.Error)
 		return httperr.Errorf(403, convoxifyCloudformationError(e.Message()))
@@ -167,6 +306,204 @@ func ServiceUpdate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	return RenderJson(rw, s)
 }
 
+func ServiceBackupList(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	s, herr := backupService(mux.Vars(r)["service"])
+	if herr != nil {
+		return herr
+	}
+
+	backups, err := s.Backups()
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, backups)
+}
+
+func ServiceBackupCreate(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	s, herr := backupService(mux.Vars(r)["service"])
+	if herr != nil {
+		return herr
+	}
+
+	if s.Status != "running" {
+		return httperr.Errorf(403, "can not back up %s while it is %s", s.Name, s.Status)
+	}
+
+	backup, err := s.Backup()
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, backup)
+}
+
+func ServiceBackupRestore(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	vars := mux.Vars(r)
+	service := vars["service"]
+
+	s, herr := backupService(service)
+	if herr != nil {
+		return herr
+	}
+
+	if s.Status != "running" {
+		return httperr.Errorf(403, "can not restore %s while it is %s", s.Name, s.Status)
+	}
+
+	if err := s.Restore(vars["backup"]); err != nil {
+		return httperr.New(403, err)
+	}
+
+	s, err := models.GetService(service)
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, s)
+}
+
+func ServiceBackupClone(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	vars := mux.Vars(r)
+
+	s, herr := backupService(vars["service"])
+	if herr != nil {
+		return herr
+	}
+
+	name := GetForm(r, "name")
+
+	if name == "" {
+		return httperr.Errorf(403, "must specify a name for the clone")
+	}
+
+	if _, err := models.GetService(name); err == nil {
+		return httperr.Errorf(403, "there is already a service named %s", name)
+	}
+
+	if _, err := s.Clone(name, vars["backup"]); err != nil {
+		return httperr.New(403, err)
+	}
+
+	clone, err := models.GetService(name)
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, clone)
+}
+
+// backupService returns a service whose type supports backups
+func backupService(name string) (*models.Service, *httperr.Error) {
+	s, err := models.GetService(name)
+	if awsError(err) == "ValidationError" {
+		return nil, httperr.Errorf(404, "no such service: %s", name)
+	}
+	if err != nil {
+		return nil, httperr.Server(err)
+	}
+
+	if t, err := structs.GetServiceType(s.Type); err != nil || !t.Backups {
+		return nil, httperr.Errorf(403, "%s services do not support backups", s.Type)
+	}
+
+	return s, nil
+}
+
+// ServiceExport streams a dump of the database of a service, followed by an exit status
+func ServiceExport(ws *websocket.Conn) *httperr.Error {
+	s, herr := exportableService(mux.Vars(ws.Request())["service"])
+	if herr != nil {
+		return herr
+	}
+
+	if err := s.Export(ws); err != nil {
+		return httperr.Server(err)
+	}
+
+	if _, err := ws.Write([]byte(fmt.Sprintf("%s%d\n", models.StatusCodePrefix, 0))); err != nil {
+		return httperr.Server(err)
+	}
+
+	return nil
+}
+
+// ServiceImport loads a dump sent over the websocket into the database of a service
+func ServiceImport(ws *websocket.Conn) *httperr.Error {
+	s, herr := exportableService(mux.Vars(ws.Request())["service"])
+	if herr != nil {
+		return herr
+	}
+
+	if err := s.Import(ws, ws); err != nil {
+		return httperr.Server(err)
+	}
+
+	if _, err := ws.Write([]byte(fmt.Sprintf("%s%d\n", models.StatusCodePrefix, 0))); err != nil {
+		return httperr.Server(err)
+	}
+
+	return nil
+}
+
+// exportableService returns a running service whose data can be exported
+func exportableService(name string) (*models.Service, *httperr.Error) {
+	s, err := models.GetService(name)
+	if awsError(err) == "ValidationError" {
+		return nil, httperr.Errorf(404, "no such service: %s", name)
+	}
+	if err != nil {
+		return nil, httperr.Server(err)
+	}
+
+	if !s.Exportable() {
+		return nil, httperr.Errorf(403, "%s services can not be exported or imported", s.Type)
+	}
+
+	if s.Status != "running" {
+		return nil, httperr.Errorf(403, "%s is %s", s.Name, s.Status)
+	}
+
+	return s, nil
+}
+
+// ServiceRotateCredentials starts replacing the password of a service and its URL in
+// the environment of linked apps
+func ServiceRotateCredentials(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	service := mux.Vars(r)["service"]
+
+	s, err := models.GetService(service)
+	if awsError(err) == "ValidationError" {
+		return httperr.Errorf(404, "no such service: %s", service)
+	}
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	t, err := structs.GetServiceType(s.Type)
+	if err != nil {
+		return httperr.New(403, err)
+	}
+
+	// provider services hold credentials of resources outside the rack that it can not change
+	if p, ok := t.Parameter("password"); t.Provider || !ok || !p.Secret {
+		return httperr.Errorf(403, "%s services have no credentials to rotate", s.Type)
+	}
+
+	if s.Status != "running" {
+		return httperr.Errorf(403, "can not rotate credentials of %s while it is %s", s.Name, s.Status)
+	}
+
+	promote := GetForm(r, "promote") == "true"
+
+	// the rotation waits for the service and every linked app, report it with events
+	go s.RotateCredentials(promote)
+
+	s.Status = "updating"
+
+	return RenderJson(rw, s)
+}
+
 func convoxifyCloudformationError(msg string) string {
 	newMsg := strings.Replace(msg, "do not exist in the template", "are not supported by this service", 1)
 	newMsg = strings.Replace(newMsg, "Parameters:", "Options:", 1)
@@ -0,0 +1,182 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/convox/rack/api/awsutil"
+	"github.com/convox/rack/api/models"
+	"github.com/convox/rack/api/provider"
+	"github.com/convox/rack/api/structs"
+	"github.com/convox/rack/client"
+	"github.com/convox/rack/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceTypeList(t *testing.T) {
+	body := test.HTTPBody("GET", "http://convox/services/types", nil)
+
+	var types client.ServiceTypes
+	err := json.Unmarshal([]byte(body), &types)
+
+	if assert.Nil(t, err) {
+		names := []string{}
+
+		for _, st := range types {
+			names = append(names, st.Name)
+		}
+
+		assert.Equal(t, []string{"elasticsearch", "external", "memcached", "mysql", "postgres", "rabbitmq", "redis", "s3", "sns", "sqs", "syslog", "webhook"}, names)
+		assert.Equal(t, "instance-count", types[0].Parameters[0].Name)
+		assert.Equal(t, "1", types[0].Parameters[0].Default)
+	}
+}
+
+func TestServiceCreateValidation(t *testing.T) {
+	create := func(values ...string) string {
+		v := url.Values{}
+
+		for i := 0; i < len(values); i += 2 {
+			v.Add(values[i], values[i+1])
+		}
+
+		return test.HTTPBody("POST", "http://convox/services", v)
+	}
+
+	assert.Equal(t, `{"error":"invalid service type: mongo, must be one of: elasticsearch, external, memcached, mysql, postgres, rabbitmq, redis, s3, sns, sqs, syslog, webhook"}`, create("name", "db", "type", "mongo"))
+	assert.Equal(t, `{"error":"papertrail is no longer supported. Create a `+"`syslog`"+` service instead"}`, create("name", "logs", "type", "papertrail"))
+	assert.Equal(t, `{"error":"invalid option for postgres: --size, must be one of: --allocated-storage, --database, --instance-type, --max-connections, --multi-az, --password, --private, --username"}`, create("name", "db", "type", "postgres", "size", "10"))
+	assert.Equal(t, `{"error":"--allocated-storage must be a number"}`, create("name", "db", "type", "postgres", "allocated-storage", "big"))
+	assert.Equal(t, `{"error":"--multi-az must be true or false"}`, create("name", "db", "type", "mysql", "multi-az", "yes"))
+	assert.Equal(t, `{"error":"--url is required for syslog"}`, create("name", "logs", "type", "syslog"))
+	assert.Equal(t, `{"error":"--url is required for external"}`, create("name", "db", "type", "external"))
+	assert.Equal(t, `{"error":"sqs services do not have options"}`, create("name", "queue", "type", "sqs", "queue", "other"))
+}
+
+func TestServiceBackupsWithServiceNotFound(t *testing.T) {
+	aws := test.StubAws(
+		test.DescribeStackNotFound("convox-test-nodb"),
+		test.DescribeStackNotFound("nodb"),
+	)
+	defer aws.Close()
+
+	test.AssertStatus(t, 404, "GET", "http://convox/services/nodb/backups", nil)
+}
+
+func TestServiceRotateCredentialsWithServiceNotFound(t *testing.T) {
+	aws := test.StubAws(
+		test.DescribeStackNotFound("convox-test-nodb"),
+		test.DescribeStackNotFound("nodb"),
+	)
+	defer aws.Close()
+
+	test.AssertStatus(t, 404, "POST", "http://convox/services/nodb/rotate-credentials", nil)
+}
+
+func TestServiceRotateCredentialsExternal(t *testing.T) {
+	aws := test.StubAws(
+		awsutil.Cycle{
+			Request: awsutil.Request{RequestURI: "/", Body: `Action=DescribeStacks&StackName=convox-test-ext&Version=2010-05-15`},
+			Response: awsutil.Response{StatusCode: 200, Body: `<DescribeStacksResult><Stacks><member>
+  <StackName>convox-test-ext</StackName>
+  <StackStatus>CREATE_COMPLETE</StackStatus>
+  <Parameters>
+    <member><ParameterKey>Url</ParameterKey><ParameterValue>postgres://db.example.org:5432/app</ParameterValue></member>
+  </Parameters>
+  <Tags>
+    <member><Key>Name</Key><Value>ext</Value></member>
+    <member><Key>Service</Key><Value>external</Value></member>
+  </Tags>
+</member></Stacks></DescribeStacksResult>`},
+		},
+	)
+	defer aws.Close()
+
+	body := test.AssertStatus(t, 403, "POST", "http://convox/services/ext/rotate-credentials", nil)
+	assert.Equal(t, `{"error":"external services have no credentials to rotate"}`, body)
+}
+
+func TestServiceDependentsWithServiceNotFound(t *testing.T) {
+	aws := test.StubAws(
+		test.DescribeStackNotFound("convox-test-nodb"),
+		test.DescribeStackNotFound("nodb"),
+	)
+	defer aws.Close()
+
+	test.AssertStatus(t, 404, "GET", "http://convox/services/nodb/dependents", nil)
+}
+
+func TestServiceDeleteForceUnlinks(t *testing.T) {
+	// apps are listed from the stub, not from stacks cached by earlier tests
+	models.DescribeStacksCache = map[string]models.DescribeStacksResult{}
+
+	aws := test.StubAws(
+		test.DescribeStackCycleWithoutQuery("convox-test-myapp"),
+	)
+	defer aws.Close()
+
+	testProvider := &provider.TestProviderRunner{
+		Service: structs.Service{Name: "primary", Type: "postgres", Status: "running"},
+		Links: structs.Links{
+			{App: "myapp", Service: "primary", Process: "web", Variable: "PRIMARY_URL"},
+			{App: "myapp", Service: "replica", Variable: "REPLICA_URL"},
+		},
+	}
+	provider.CurrentProvider = testProvider
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	testProvider.On("ServiceGet", "primary").Return(&testProvider.Service, nil)
+	// the stub lists myapp and the rack stack, which is not an app
+	testProvider.On("LinkList", "myapp").Return(testProvider.Links, nil)
+	testProvider.On("ServiceUnlink", "primary", "myapp", "web").Return(&testProvider.Service, nil)
+	testProvider.On("ServiceDelete", "primary").Return(&testProvider.Service, nil)
+
+	test.AssertStatus(t, 200, "DELETE", "http://convox/services/primary", url.Values{"force": []string{"true"}})
+	testProvider.AssertExpectations(t)
+	testProvider.AssertNotCalled(t, "ServiceUnlink", "replica", "myapp", "")
+}
+
+func TestLinkCreateAsProcess(t *testing.T) {
+	testProvider := &provider.TestProviderRunner{
+		Service: structs.Service{Name: "primary", Type: "postgres", Status: "running"},
+	}
+	provider.CurrentProvider = testProvider
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	testProvider.On("ServiceGet", "primary").Return(&testProvider.Service, nil)
+	testProvider.On("ServiceLink", "primary", "myapp", "web", "PRIMARY_DB").Return(&testProvider.Service, nil)
+
+	v := url.Values{"app": []string{"myapp"}, "process": []string{"web"}, "as": []string{"PRIMARY_DB"}}
+
+	test.AssertStatus(t, 200, "POST", "http://convox/services/primary/links", v)
+	testProvider.AssertExpectations(t)
+}
+
+func TestLinkList(t *testing.T) {
+	testProvider := &provider.TestProviderRunner{
+		Links: structs.Links{
+			{App: "myapp", Service: "primary", Variable: "PRIMARY_DB_URL"},
+			{App: "myapp", Service: "replica", Process: "worker", Variable: "REPLICA_URL"},
+		},
+	}
+	provider.CurrentProvider = testProvider
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	testProvider.On("LinkList", "myapp").Return(testProvider.Links, nil)
+
+	body := test.HTTPBody("GET", "http://convox/apps/myapp/links", nil)
+
+	var links structs.Links
+	err := json.Unmarshal([]byte(body), &links)
+
+	if assert.Nil(t, err) {
+		assert.Equal(t, testProvider.Links, links)
+	}
+}
@@ -26,6 +26,15 @@ func SystemReleaseList(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	return RenderJson(rw, releases)
 }
 
+func SystemGraph(rw http.ResponseWriter, r *http.Request) *httperr.Error {
+	graph, err := models.GetSystemGraph()
+	if err != nil {
+		return httperr.Server(err)
+	}
+
+	return RenderJson(rw, graph)
+}
+
 func SystemShow(rw http.ResponseWriter, r *http.Request) *httperr.Error {
 	rack, err := provider.SystemGet()
 	if awsError(err) == "ValidationError" {
@@ -0,0 +1,361 @@
+// Package fswatch reports batches of files added, modified or deleted below a directory.
+// It uses native filesystem events where available and falls back to polling.
+package fswatch
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/builder/dockerignore"
+	"github.com/docker/docker/pkg/fileutils"
+)
+
+// Change is a file added or deleted below a watched directory. Modified files are added.
+type Change struct {
+	Operation string
+	Base      string
+	Path      string
+}
+
+// String returns the change in the operation|base|path format read by convox start
+func (c Change) String() string {
+	return fmt.Sprintf("%s|%s|%s", c.Operation, c.Base, c.Path)
+}
+
+// Options control how a directory is watched
+type Options struct {
+	// IgnoreFile is a .dockerignore whose patterns, relative to its directory, exclude files.
+	// It is reread when it changes.
+	IgnoreFile string
+
+	// Interval is how long changes are batched for, and how often the tree is polled
+	// when native events are unavailable
+	Interval time.Duration
+
+	// Poll disables native events
+	Poll bool
+}
+
+// errNativeUnavailable is returned by watchNative when polling has to take over
+var errNativeUnavailable = errors.New("native filesystem events unavailable")
+
+// DefaultInterval batches changes when Options does not set an interval
+var DefaultInterval = 900 * time.Millisecond
+
+// Watch sends batches of changes below dir on ch until an error occurs. Files that
+// exist when the watch starts are not reported.
+func Watch(dir string, opts Options, ch chan []Change) error {
+	if opts.Interval == 0 {
+		opts.Interval = DefaultInterval
+	}
+
+	t, err := newTree(dir, opts.IgnoreFile)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Poll {
+		err := watchNative(t, opts, ch)
+		if err != errNativeUnavailable {
+			return err
+		}
+	}
+
+	return watchPoll(t, opts, ch)
+}
+
+// watchPoll rescans the tree every interval
+func watchPoll(t *tree, opts Options, ch chan []Change) error {
+	for {
+		time.Sleep(opts.Interval)
+
+		if changes := t.scan(); len(changes) > 0 {
+			ch <- changes
+		}
+	}
+}
+
+// batch collects the adds and deletes of files between flushes, keeping the last
+// operation for each file
+type batch struct {
+	adds    map[string]bool
+	deletes map[string]bool
+}
+
+func newBatch() *batch {
+	return &batch{adds: map[string]bool{}, deletes: map[string]bool{}}
+}
+
+func (b *batch) add(adds, deletes []string) {
+	for _, path := range deletes {
+		delete(b.adds, path)
+		b.deletes[path] = true
+	}
+
+	for _, path := range adds {
+		delete(b.deletes, path)
+		b.adds[path] = true
+	}
+}
+
+// flush sends the collected changes, if any, and resets the batch
+func (b *batch) flush(t *tree, ch chan []Change) {
+	if len(b.adds) == 0 && len(b.deletes) == 0 {
+		return
+	}
+
+	adds := []string{}
+	deletes := []string{}
+
+	for path := range b.adds {
+		adds = append(adds, path)
+	}
+
+	for path := range b.deletes {
+		deletes = append(deletes, path)
+	}
+
+	b.adds = map[string]bool{}
+	b.deletes = map[string]bool{}
+
+	ch <- t.changes(adds, deletes)
+}
+
+// tree tracks the files below a directory that are not ignored
+type tree struct {
+	dir   string
+	files map[string]time.Time
+
+	ignoreFile string
+	ignoreTime time.Time
+	patterns   []string
+	patDirs    [][]string
+	exceptions bool
+}
+
+func newTree(dir, ignoreFile string) (*tree, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	sym, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return nil, err
+	}
+
+	// match ignore patterns against the same resolved paths as the tree
+	if ignoreFile != "" {
+		abs, err := filepath.Abs(ignoreFile)
+		if err != nil {
+			return nil, err
+		}
+
+		dir, err := filepath.EvalSymlinks(filepath.Dir(abs))
+		if err != nil {
+			return nil, err
+		}
+
+		ignoreFile = filepath.Join(dir, filepath.Base(abs))
+	}
+
+	t := &tree{dir: sym, files: map[string]time.Time{}, ignoreFile: ignoreFile}
+
+	if err := t.loadIgnore(); err != nil {
+		return nil, err
+	}
+
+	t.scan()
+
+	return t, nil
+}
+
+// loadIgnore reads the ignore patterns if the ignore file changed since they were last read
+func (t *tree) loadIgnore() error {
+	if t.ignoreFile == "" {
+		return nil
+	}
+
+	info, err := os.Stat(t.ignoreFile)
+	if os.IsNotExist(err) {
+		t.ignoreTime = time.Time{}
+		t.patterns, t.patDirs, t.exceptions = nil, nil, false
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if info.ModTime().Equal(t.ignoreTime) {
+		return nil
+	}
+
+	fd, err := os.Open(t.ignoreFile)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	lines, err := dockerignore.ReadAll(fd)
+	if err != nil {
+		return err
+	}
+
+	patterns, patDirs, exceptions, err := fileutils.CleanPatterns(lines)
+	if err != nil {
+		return err
+	}
+
+	t.ignoreTime = info.ModTime()
+	t.patterns, t.patDirs, t.exceptions = patterns, patDirs, exceptions
+
+	return nil
+}
+
+// ignored returns true if a path is excluded by the ignore file
+func (t *tree) ignored(path string) bool {
+	if len(t.patterns) == 0 {
+		return false
+	}
+
+	rel, err := filepath.Rel(filepath.Dir(t.ignoreFile), path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return false
+	}
+
+	skip, err := fileutils.OptimizedMatches(rel, t.patterns, t.patDirs)
+	if err != nil {
+		return false
+	}
+
+	return skip
+}
+
+// skipDir returns true if no file below a directory can be included
+func (t *tree) skipDir(path string) bool {
+	return !t.exceptions && t.ignored(path)
+}
+
+// walk calls fn for every file below path that is not ignored
+func (t *tree) walk(path string, fn func(path string, info os.FileInfo)) {
+	filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if info.IsDir() {
+			if p != t.dir && t.skipDir(p) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !t.ignored(p) {
+			fn(p, info)
+		}
+
+		return nil
+	})
+}
+
+// scan walks the whole tree and returns the changes since the last scan
+func (t *tree) scan() []Change {
+	return t.changes(t.diff())
+}
+
+// diff walks the whole tree and returns the files added and deleted since the last walk
+func (t *tree) diff() ([]string, []string) {
+	t.loadIgnore()
+
+	seen := map[string]bool{}
+	adds := []string{}
+
+	t.walk(t.dir, func(path string, info os.FileInfo) {
+		seen[path] = true
+
+		if mt, ok := t.files[path]; !ok || mt.Before(info.ModTime()) {
+			t.files[path] = info.ModTime()
+			adds = append(adds, path)
+		}
+	})
+
+	deletes := []string{}
+
+	for path := range t.files {
+		if !seen[path] {
+			delete(t.files, path)
+			deletes = append(deletes, path)
+		}
+	}
+
+	return adds, deletes
+}
+
+// add records a created or modified path, descending into directories
+func (t *tree) add(path string) []string {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil
+	}
+
+	if info.IsDir() {
+		adds := []string{}
+
+		t.walk(path, func(p string, info os.FileInfo) {
+			t.files[p] = info.ModTime()
+			adds = append(adds, p)
+		})
+
+		return adds
+	}
+
+	if t.ignored(path) {
+		return nil
+	}
+
+	t.files[path] = info.ModTime()
+
+	return []string{path}
+}
+
+// remove forgets a deleted path and any files below it
+func (t *tree) remove(path string) []string {
+	deletes := []string{}
+
+	for p := range t.files {
+		if p == path || strings.HasPrefix(p, path+string(filepath.Separator)) {
+			delete(t.files, p)
+			deletes = append(deletes, p)
+		}
+	}
+
+	return deletes
+}
+
+// changes converts absolute paths to sorted changes relative to the tree
+func (t *tree) changes(adds, deletes []string) []Change {
+	changes := []Change{}
+
+	sort.Strings(deletes)
+	sort.Strings(adds)
+
+	for _, path := range deletes {
+		if rel, err := filepath.Rel(t.dir, path); err == nil {
+			changes = append(changes, Change{Operation: "delete", Base: t.dir, Path: rel})
+		}
+	}
+
+	for _, path := range adds {
+		if rel, err := filepath.Rel(t.dir, path); err == nil {
+			changes = append(changes, Change{Operation: "add", Base: t.dir, Path: rel})
+		}
+	}
+
+	return changes
+}
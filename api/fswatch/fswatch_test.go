@@ -0,0 +1,103 @@
+package fswatch
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func testDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "fswatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.MkdirAll(filepath.Join(dir, "lib"), 0755)
+	os.MkdirAll(filepath.Join(dir, "node_modules", "left-pad"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, ".dockerignore"), []byte("node_modules\n*.log\n"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "lib", "app.rb"), []byte("app"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "old.rb"), []byte("old"), 0644)
+
+	return dir
+}
+
+// collect gathers the distinct changes until none arrive for a few intervals
+func collect(ch chan []Change) []string {
+	seen := map[string]bool{}
+
+	for {
+		select {
+		case changes := <-ch:
+			for _, c := range changes {
+				seen[c.Operation+"|"+c.Path] = true
+			}
+		case <-time.After(500 * time.Millisecond):
+			got := []string{}
+
+			for c := range seen {
+				got = append(got, c)
+			}
+
+			sort.Strings(got)
+
+			return got
+		}
+	}
+}
+
+func modify(dir string) {
+	os.MkdirAll(filepath.Join(dir, "lib", "models"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "lib", "models", "user.rb"), []byte("user"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "lib", "app.rb"), []byte("app changed"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "node_modules", "left-pad", "index.js"), []byte("pad"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "debug.log"), []byte("log"), 0644)
+	os.Remove(filepath.Join(dir, "old.rb"))
+
+	// polling compares modification times
+	later := time.Now().Add(1 * time.Minute)
+	os.Chtimes(filepath.Join(dir, "lib", "app.rb"), later, later)
+}
+
+var expected = []string{
+	"add|lib/app.rb",
+	"add|lib/models/user.rb",
+	"delete|old.rb",
+}
+
+func testWatch(t *testing.T, poll bool) {
+	dir := testDir(t)
+	defer os.RemoveAll(dir)
+
+	ch := make(chan []Change)
+
+	go Watch(dir, Options{IgnoreFile: filepath.Join(dir, ".dockerignore"), Interval: 50 * time.Millisecond, Poll: poll}, ch)
+
+	// let the watch take its initial snapshot
+	time.Sleep(200 * time.Millisecond)
+
+	modify(dir)
+
+	if got := collect(ch); !reflect.DeepEqual(got, expected) {
+		t.Errorf("changes:\n got: %v\nwant: %v", got, expected)
+	}
+}
+
+func TestWatchNative(t *testing.T) {
+	testWatch(t, false)
+}
+
+func TestWatchPoll(t *testing.T) {
+	testWatch(t, true)
+}
+
+func TestChangeString(t *testing.T) {
+	c := Change{Operation: "add", Base: "/app", Path: "lib/app.rb"}
+
+	if s := c.String(); s != "add|/app|lib/app.rb" {
+		t.Errorf("unexpected change string: %s", s)
+	}
+}
@@ -0,0 +1,184 @@
+package fswatch
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+const inotifyMask = syscall.IN_ATTRIB | syscall.IN_CLOSE_WRITE | syscall.IN_CREATE |
+	syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO
+
+type inotifyEvent struct {
+	Wd   int
+	Mask uint32
+	Name string
+}
+
+type inotify struct {
+	fd      int
+	tree    *tree
+	watches map[int]string
+}
+
+// watchNative follows inotify events, batching them for the interval. It returns
+// errNativeUnavailable when inotify can not be used, e.g. when the watch limit is reached,
+// leaving the tree up to date for polling to take over.
+func watchNative(t *tree, opts Options, ch chan []Change) error {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC)
+	if err != nil {
+		return errNativeUnavailable
+	}
+
+	in := &inotify{fd: fd, tree: t, watches: map[int]string{}}
+
+	defer syscall.Close(fd)
+
+	if err := in.watch(t.dir); err != nil {
+		return errNativeUnavailable
+	}
+
+	events := make(chan inotifyEvent)
+	errch := make(chan error, 1)
+	done := make(chan bool)
+
+	defer close(done)
+
+	go in.read(events, errch, done)
+
+	// files changed between the initial scan and the watches being added
+	b := newBatch()
+	b.add(t.diff())
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case e := <-events:
+			if err := in.handle(e, b); err != nil {
+				b.add(t.diff())
+				b.flush(t, ch)
+				return errNativeUnavailable
+			}
+		case <-ticker.C:
+			b.flush(t, ch)
+		case <-errch:
+			b.add(t.diff())
+			b.flush(t, ch)
+			return errNativeUnavailable
+		}
+	}
+}
+
+// watch adds a watch for a directory and every directory below it that is not ignored
+func (in *inotify) watch(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+
+		if path != in.tree.dir && in.tree.skipDir(path) {
+			return filepath.SkipDir
+		}
+
+		wd, err := syscall.InotifyAddWatch(in.fd, path, inotifyMask)
+		if err != nil {
+			return err
+		}
+
+		in.watches[wd] = path
+
+		return nil
+	})
+}
+
+// unwatch removes the watches of a directory moved out of the tree
+func (in *inotify) unwatch(dir string) {
+	for wd, path := range in.watches {
+		if path == dir || strings.HasPrefix(path, dir+string(filepath.Separator)) {
+			syscall.InotifyRmWatch(in.fd, uint32(wd))
+			delete(in.watches, wd)
+		}
+	}
+}
+
+// read parses events from the inotify file descriptor
+func (in *inotify) read(events chan inotifyEvent, errch chan error, done chan bool) {
+	buf := make([]byte, 64*1024)
+
+	for {
+		n, err := syscall.Read(in.fd, buf)
+		if err != nil || n <= 0 {
+			errch <- err
+			return
+		}
+
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			raw := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			start := offset + syscall.SizeofInotifyEvent
+			name := strings.TrimRight(string(buf[start:start+int(raw.Len)]), "\x00")
+
+			offset = start + int(raw.Len)
+
+			select {
+			case events <- inotifyEvent{Wd: int(raw.Wd), Mask: raw.Mask, Name: name}:
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+// handle applies an event to the tree and records the resulting changes in a batch
+func (in *inotify) handle(e inotifyEvent, b *batch) error {
+	t := in.tree
+
+	if e.Mask&syscall.IN_Q_OVERFLOW != 0 {
+		b.add(t.diff())
+		return nil
+	}
+
+	if e.Mask&syscall.IN_IGNORED != 0 {
+		delete(in.watches, e.Wd)
+		return nil
+	}
+
+	dir, ok := in.watches[e.Wd]
+	if !ok || e.Name == "" {
+		return nil
+	}
+
+	path := filepath.Join(dir, e.Name)
+
+	switch {
+	case path == t.ignoreFile:
+		// patterns changed, rescan and watch directories that are no longer ignored
+		if err := in.watch(t.dir); err != nil {
+			return err
+		}
+
+		b.add(t.diff())
+	case e.Mask&(syscall.IN_DELETE|syscall.IN_MOVED_FROM) != 0:
+		if e.Mask&syscall.IN_ISDIR != 0 {
+			in.unwatch(path)
+		}
+
+		b.add(nil, t.remove(path))
+	case e.Mask&syscall.IN_ISDIR != 0:
+		if e.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+			if err := in.watch(path); err != nil {
+				return err
+			}
+
+			b.add(t.add(path), nil)
+		}
+	default:
+		b.add(t.add(path), nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package fswatch
+
+// watchNative is only implemented on linux, other platforms poll
+func watchNative(t *tree, opts Options, ch chan []Change) error {
+	return errNativeUnavailable
+}
@@ -0,0 +1,666 @@
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/docker/go-units"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DependsOn lists the entries an entry starts after. It can be declared as a
+// list or, as in compose file 2.1, as a map of entries to start conditions.
+type DependsOn []string
+
+// UnmarshalYAML implements the Unmarshaller interface.
+func (d *DependsOn) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	switch t := value.(type) {
+	case []interface{}:
+		for _, name := range t {
+			*d = append(*d, fmt.Sprintf("%v", name))
+		}
+	case map[interface{}]interface{}:
+		for name, v := range t {
+			if opts, ok := v.(map[interface{}]interface{}); ok {
+				if c, ok := opts["condition"].(string); ok && c != "service_started" {
+					return fmt.Errorf("depends_on condition %s is not supported, only service_started", c)
+				}
+			}
+
+			*d = append(*d, fmt.Sprintf("%v", name))
+		}
+
+		sort.Strings(*d)
+	default:
+		return fmt.Errorf("depends_on must be a list or a map")
+	}
+
+	return nil
+}
+
+// StringList is a list of strings that can also be declared as a single string
+type StringList []string
+
+// UnmarshalYAML implements the Unmarshaller interface.
+func (l *StringList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	switch t := value.(type) {
+	case string:
+		*l = StringList{t}
+	case []interface{}:
+		for _, s := range t {
+			*l = append(*l, fmt.Sprintf("%v", s))
+		}
+	default:
+		return fmt.Errorf("must be a string or a list")
+	}
+
+	return nil
+}
+
+// Extends names an entry, optionally in another file, whose configuration an entry inherits
+type Extends struct {
+	File    string `yaml:"file,omitempty"`
+	Service string `yaml:"service"`
+}
+
+// UnmarshalYAML implements the Unmarshaller interface.
+func (e *Extends) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	switch t := value.(type) {
+	case string:
+		e.Service = t
+	case map[interface{}]interface{}:
+		e.File, _ = t["file"].(string)
+		e.Service, _ = t["service"].(string)
+	default:
+		return fmt.Errorf("extends must be a service name or a map with service and file keys")
+	}
+
+	return nil
+}
+
+// restart policies that match how the rack runs processes, which are always restarted
+var restartPolicies = map[string]bool{
+	"":               true,
+	"always":         true,
+	"unless-stopped": true,
+}
+
+// restartOnExit returns true if the container of an entry that exited should be started
+// again by convox start. Containers that failed to start are not restarted.
+func (me ManifestEntry) restartOnExit(err error, stopping chan bool) bool {
+	select {
+	case <-stopping:
+		return false
+	default:
+	}
+
+	if _, ok := err.(*ContainerStartError); ok {
+		return false
+	}
+
+	return me.Restart == "always" || me.Restart == "unless-stopped"
+}
+
+// MemoryLimit returns the mem_limit of an entry in bytes, or 0 when it has none
+func (me ManifestEntry) MemoryLimit() (int64, error) {
+	switch t := me.MemLimit.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return int64(t), nil
+	case string:
+		return units.RAMInBytes(t)
+	default:
+		return 0, fmt.Errorf("mem_limit must be a number of bytes or a size like 512m")
+	}
+}
+
+// reInterpolate matches ${VAR}, ${VAR:-default}, ${VAR-default}, ${VAR:?error}, ${VAR?error}
+// and $${ which escapes a ${. Unbraced $VAR and $$ are left for the container shell.
+var reInterpolate = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)(?:(:?[-?])([^}]*))?\}`)
+
+// interpolate substitutes environment variables into a value from a manifest.
+// Variables that are not set and have no default are left as they are.
+func interpolate(s string) (string, error) {
+	var ierr error
+
+	out := reInterpolate.ReplaceAllStringFunc(s, func(match string) string {
+		if match == "$${" {
+			return "${"
+		}
+
+		parts := reInterpolate.FindStringSubmatch(match)
+		name, op, arg := parts[1], parts[2], parts[3]
+		value, set := os.LookupEnv(name)
+
+		switch op {
+		case ":-":
+			if value == "" {
+				return arg
+			}
+		case "-":
+			if !set {
+				return arg
+			}
+		case ":?", "?":
+			if !set || (op == ":?" && value == "") {
+				if arg == "" {
+					arg = "required"
+				}
+
+				ierr = fmt.Errorf("variable %s is not set: %s", name, arg)
+			}
+		default:
+			if !set {
+				return match
+			}
+		}
+
+		return value
+	})
+
+	return out, ierr
+}
+
+// interpolateYAML substitutes environment variables into every string value of a manifest
+func interpolateYAML(data []byte) ([]byte, error) {
+	var value interface{}
+
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, &YAMLError{err}
+	}
+
+	value, err := interpolateValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(value)
+}
+
+func interpolateValue(value interface{}) (interface{}, error) {
+	switch t := value.(type) {
+	case string:
+		return interpolate(t)
+	case []interface{}:
+		for i, v := range t {
+			iv, err := interpolateValue(v)
+			if err != nil {
+				return nil, err
+			}
+
+			t[i] = iv
+		}
+	case map[interface{}]interface{}:
+		for k, v := range t {
+			iv, err := interpolateValue(v)
+			if err != nil {
+				return nil, err
+			}
+
+			t[k] = iv
+		}
+	}
+
+	return value, nil
+}
+
+// readEnvFile returns the KEY=VALUE lines of an env file, skipping blanks and comments
+func readEnvFile(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	env := []string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") || !strings.Contains(line, "=") {
+			continue
+		}
+
+		env = append(env, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
+
+// loadDotEnv sets the variables in the .env file of a directory so they are available for interpolation
+func loadDotEnv(dir string) error {
+	denv := filepath.Join(dir, ".env")
+
+	if !exists(denv) {
+		return nil
+	}
+
+	env, err := readEnvFile(denv)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range env {
+		parts := strings.SplitN(e, "=", 2)
+
+		if err := os.Setenv(parts[0], parts[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Interpolate returns a manifest and the files it extends with variables from the
+// environment and the .env file of dir substituted, keyed by their path relative to dir.
+// Files without variables are left out. The cli uploads these in place of the originals
+// because builds read manifests as written.
+func Interpolate(dir, filename string) (map[string][]byte, error) {
+	if err := loadDotEnv(dir); err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	seen := map[string]bool{}
+
+	var walk func(path string) error
+
+	walk = func(path string) error {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || strings.HasPrefix(rel, "..") || seen[rel] {
+			return nil
+		}
+
+		seen[rel] = true
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if reInterpolate.Match(data) {
+			idata, err := interpolateYAML(data)
+			if err != nil {
+				return err
+			}
+
+			files[rel] = idata
+		}
+
+		services, err := readServices(path, true)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range services {
+			if entry.Extends == nil || entry.Extends.File == "" {
+				continue
+			}
+
+			other := entry.Extends.File
+
+			if !filepath.IsAbs(other) {
+				other = filepath.Join(filepath.Dir(path), other)
+			}
+
+			if err := walk(other); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk(filepath.Join(dir, filename)); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// envKey returns the name of a KEY=VALUE or KEY environment entry
+func envKey(env string) string {
+	return strings.SplitN(env, "=", 2)[0]
+}
+
+// applyEnvFiles merges the env_file entries of an entry into its environment.
+// Values declared in environment take precedence.
+func (me *ManifestEntry) applyEnvFiles(dir string) error {
+	if len(me.EnvFile) == 0 {
+		return nil
+	}
+
+	declared := me.EnvironmentArray()
+	keys := map[string]bool{}
+
+	for _, env := range declared {
+		keys[envKey(env)] = true
+	}
+
+	merged := []interface{}{}
+
+	for _, file := range me.EnvFile {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+
+		env, err := readEnvFile(file)
+		if err != nil {
+			return fmt.Errorf("unable to read env_file: %s", err)
+		}
+
+		for _, e := range env {
+			if !keys[envKey(e)] {
+				keys[envKey(e)] = true
+				merged = append(merged, e)
+			}
+		}
+	}
+
+	for _, env := range declared {
+		merged = append(merged, env)
+	}
+
+	me.Environment = merged
+	me.EnvFile = nil
+
+	return nil
+}
+
+// readServices parses the entries of a manifest file without resolving them
+func readServices(path string, interpolated bool) (Manifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if interpolated {
+		data, err = interpolateYAML(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var mv2 ManifestV2
+
+	if err := yaml.Unmarshal(data, &mv2); err != nil {
+		return nil, &YAMLError{err}
+	}
+
+	if mv2.Version != "" {
+		return mv2.Services, nil
+	}
+
+	var m Manifest
+
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, &YAMLError{err}
+	}
+
+	return m, nil
+}
+
+// resolveExtends replaces every entry that extends another with the merged configuration
+func (m Manifest) resolveExtends(dir string, interpolated bool) error {
+	for name := range m {
+		entry, err := m.extend(dir, name, interpolated, map[string]bool{})
+		if err != nil {
+			return err
+		}
+
+		m[name] = entry
+	}
+
+	return nil
+}
+
+func (m Manifest) extend(dir, name string, interpolated bool, seen map[string]bool) (ManifestEntry, error) {
+	entry := m[name]
+
+	if entry.Extends == nil {
+		return entry, nil
+	}
+
+	if seen[name] {
+		return entry, fmt.Errorf("%s extends itself through %s", name, entry.Extends.Service)
+	}
+
+	seen[name] = true
+
+	var base ManifestEntry
+
+	if entry.Extends.File == "" {
+		if _, ok := m[entry.Extends.Service]; !ok {
+			return entry, fmt.Errorf("%s extends unknown service %s", name, entry.Extends.Service)
+		}
+
+		b, err := m.extend(dir, entry.Extends.Service, interpolated, seen)
+		if err != nil {
+			return entry, err
+		}
+
+		base = b
+	} else {
+		path := entry.Extends.File
+
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		other, err := readServices(path, interpolated)
+		if err != nil {
+			return entry, fmt.Errorf("unable to read %s extended by %s: %s", entry.Extends.File, name, err)
+		}
+
+		if _, ok := other[entry.Extends.Service]; !ok {
+			return entry, fmt.Errorf("%s extends unknown service %s in %s", name, entry.Extends.Service, entry.Extends.File)
+		}
+
+		b, err := other.extend(filepath.Dir(path), entry.Extends.Service, interpolated, map[string]bool{})
+		if err != nil {
+			return entry, err
+		}
+
+		// env files and build contexts of the base are relative to its own file
+		if err := b.applyEnvFiles(filepath.Dir(path)); err != nil {
+			return entry, err
+		}
+
+		if b.Build != nil && !filepath.IsAbs(b.Build.Context) {
+			if rel, err := filepath.Rel(dir, filepath.Join(filepath.Dir(path), b.Build.Context)); err == nil {
+				b.Build.Context = rel
+			}
+		}
+
+		base = b
+	}
+
+	return mergeEntries(base, entry), nil
+}
+
+// mergeEntries applies an entry over the entry it extends. Like docker-compose, links and
+// depends_on are not inherited, environment and labels are merged and ports and volumes
+// are combined.
+func mergeEntries(base, entry ManifestEntry) ManifestEntry {
+	merged := entry
+	merged.Extends = nil
+
+	if merged.Build == nil && merged.Image == "" {
+		merged.Build = base.Build
+		merged.Image = base.Image
+	}
+
+	if merged.Dockerfile == "" {
+		merged.Dockerfile = base.Dockerfile
+	}
+
+	if merged.Command == nil {
+		merged.Command = base.Command
+	}
+
+	if merged.Entrypoint == "" {
+		merged.Entrypoint = base.Entrypoint
+	}
+
+	if merged.Healthcheck == nil {
+		merged.Healthcheck = base.Healthcheck
+	}
+
+	if merged.MemLimit == nil {
+		merged.MemLimit = base.MemLimit
+	}
+
+	if !merged.Privileged {
+		merged.Privileged = base.Privileged
+	}
+
+	if merged.Restart == "" {
+		merged.Restart = base.Restart
+	}
+
+	if merged.User == "" {
+		merged.User = base.User
+	}
+
+	if merged.WorkingDir == "" {
+		merged.WorkingDir = base.WorkingDir
+	}
+
+	merged.EnvFile = append(append(StringList{}, base.EnvFile...), entry.EnvFile...)
+
+	if base.Environment != nil || entry.Environment != nil {
+		merged.Environment = mergeKeyValues(base.EnvironmentArray(), entry.EnvironmentArray())
+	}
+
+	if base.Labels != nil || entry.Labels != nil {
+		merged.Labels = mergeKeyValues(labelArray(base.Labels), labelArray(entry.Labels))
+	}
+
+	merged.Ports = appendUnique(listValues(base.Ports), listValues(entry.Ports))
+	merged.Volumes = appendUniqueStrings(base.Volumes, entry.Volumes)
+
+	if len(merged.Ports.([]interface{})) == 0 {
+		merged.Ports = nil
+	}
+
+	return merged
+}
+
+// mergeKeyValues combines KEY=VALUE lists, values in over taking precedence
+func mergeKeyValues(base, over []string) []interface{} {
+	values := map[string]string{}
+
+	for _, kv := range append(base, over...) {
+		values[envKey(kv)] = kv
+	}
+
+	keys := []string{}
+
+	for k := range values {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	merged := []interface{}{}
+
+	for _, k := range keys {
+		merged = append(merged, values[k])
+	}
+
+	return merged
+}
+
+// labelArray returns labels declared as a map or list as a list of KEY=VALUE
+func labelArray(labels interface{}) []string {
+	arr := []string{}
+
+	switch t := labels.(type) {
+	case map[interface{}]interface{}:
+		for k, v := range t {
+			arr = append(arr, fmt.Sprintf("%v=%v", k, v))
+		}
+	case []interface{}:
+		for _, l := range t {
+			arr = append(arr, fmt.Sprintf("%v", l))
+		}
+	}
+
+	return arr
+}
+
+func listValues(value interface{}) []interface{} {
+	switch t := value.(type) {
+	case []interface{}:
+		return t
+	case []string:
+		values := []interface{}{}
+
+		for _, s := range t {
+			values = append(values, s)
+		}
+
+		return values
+	}
+
+	return []interface{}{}
+}
+
+func appendUnique(base, more []interface{}) []interface{} {
+	values := []interface{}{}
+	seen := map[string]bool{}
+
+	for _, v := range append(append([]interface{}{}, base...), more...) {
+		if k := fmt.Sprintf("%v", v); !seen[k] {
+			seen[k] = true
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+func appendUniqueStrings(base, more []string) []string {
+	values := []string{}
+
+	for _, v := range appendUnique(listValues(base), listValues(more)) {
+		values = append(values, v.(string))
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values
+}
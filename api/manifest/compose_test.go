@@ -0,0 +1,217 @@
+package manifest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeManifestDir(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "convox-compose")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestReadComposeFeatures(t *testing.T) {
+	os.Setenv("COMPOSE_TEST_IMAGE", "convox/web")
+	os.Unsetenv("COMPOSE_TEST_MISSING")
+	defer os.Unsetenv("COMPOSE_TEST_IMAGE")
+
+	dir := writeManifestDir(t, map[string]string{
+		"docker-compose.yml": `version: "2"
+services:
+  base:
+    image: ${COMPOSE_TEST_IMAGE}
+    environment:
+      - SHARED=base
+      - LEVEL=debug
+    labels:
+      - convox.port.443.protocol=tls
+  web:
+    extends: base
+    command: bin/web --port ${COMPOSE_TEST_MISSING:-3000} --home $$HOME
+    depends_on:
+      - worker
+    env_file: web.env
+    environment:
+      - LEVEL=info
+    mem_limit: 512m
+    restart: always
+    user: app
+    working_dir: /app
+  worker:
+    extends:
+      file: common.yml
+      service: worker
+`,
+		"common.yml": `worker:
+  image: convox/worker
+  environment:
+    - QUEUE=default
+`,
+		"web.env": "# comment\nSECRET=from-file\nLEVEL=from-file\n",
+	})
+	defer os.RemoveAll(dir)
+
+	m, err := ReadInterpolated(dir, "docker-compose.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	web := (*m)["web"]
+	worker := (*m)["worker"]
+	memory, _ := web.MemoryLimit()
+
+	cases := Cases{
+		{web.Image, "convox/web"},
+		{web.Command, "bin/web --port 3000 --home $$HOME"},
+		{web.EnvironmentArray(), []string{"SECRET=from-file", "LEVEL=info", "SHARED=base"}},
+		{web.Label("convox.port.443.protocol"), "tls"},
+		{memory, int64(512 * 1024 * 1024)},
+		{web.User, "app"},
+		{web.WorkingDir, "/app"},
+		{worker.Image, "convox/worker"},
+		{worker.EnvironmentArray(), []string{"QUEUE=default"}},
+		{m.runOrder(), []string{"base", "worker", "web"}},
+	}
+
+	_assert(t, cases)
+}
+
+func TestInterpolate(t *testing.T) {
+	os.Setenv("COMPOSE_TEST_SET", "value")
+	os.Setenv("COMPOSE_TEST_EMPTY", "")
+	defer os.Unsetenv("COMPOSE_TEST_SET")
+	defer os.Unsetenv("COMPOSE_TEST_EMPTY")
+
+	interpolated := func(s string) string {
+		out, err := interpolate(s)
+		if err != nil {
+			return "error: " + err.Error()
+		}
+		return out
+	}
+
+	cases := Cases{
+		{interpolated("${COMPOSE_TEST_SET}"), "value"},
+		{interpolated("${COMPOSE_TEST_EMPTY:-default}"), "default"},
+		{interpolated("${COMPOSE_TEST_EMPTY-default}"), ""},
+		{interpolated("${COMPOSE_TEST_UNSET-default}"), "default"},
+		{interpolated("${COMPOSE_TEST_UNSET}"), "${COMPOSE_TEST_UNSET}"},
+		{interpolated("$${COMPOSE_TEST_SET} $COMPOSE_TEST_SET"), "${COMPOSE_TEST_SET} $COMPOSE_TEST_SET"},
+		{interpolated("echo $$ $$HOME"), "echo $$ $$HOME"},
+		{interpolated("${COMPOSE_TEST_UNSET?must be set}"), "error: variable COMPOSE_TEST_UNSET is not set: must be set"},
+		{interpolated("${COMPOSE_TEST_EMPTY:?}"), "error: variable COMPOSE_TEST_EMPTY is not set: required"},
+	}
+
+	_assert(t, cases)
+}
+
+func TestReadKeepsVariables(t *testing.T) {
+	os.Setenv("COMPOSE_TEST_SECRET", "secret")
+	os.Unsetenv("COMPOSE_TEST_DOTENV")
+	defer os.Unsetenv("COMPOSE_TEST_SECRET")
+
+	dir := writeManifestDir(t, map[string]string{
+		"docker-compose.yml": `web:
+  image: ${COMPOSE_TEST_DOTENV:-convox/web}
+  environment:
+    - PASSWORD=${COMPOSE_TEST_SECRET}
+`,
+		".env": "COMPOSE_TEST_DOTENV=convox/other\n",
+	})
+	defer os.RemoveAll(dir)
+
+	// builds read and store the manifest as written
+	m, err := Read(dir, "docker-compose.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := m.Raw()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, dotenv := os.LookupEnv("COMPOSE_TEST_DOTENV")
+	web := (*m)["web"]
+
+	cases := Cases{
+		{web.Image, "${COMPOSE_TEST_DOTENV:-convox/web}"},
+		{web.EnvironmentArray(), []string{"PASSWORD=${COMPOSE_TEST_SECRET}"}},
+		{strings.Contains(string(data), "secret"), false},
+		{dotenv, false},
+	}
+
+	_assert(t, cases)
+}
+
+func TestInterpolateFiles(t *testing.T) {
+	os.Setenv("COMPOSE_TEST_IMAGE", "convox/web")
+	defer os.Unsetenv("COMPOSE_TEST_IMAGE")
+
+	dir := writeManifestDir(t, map[string]string{
+		"docker-compose.yml": `web:
+  extends:
+    file: common.yml
+    service: web
+worker:
+  extends:
+    file: plain.yml
+    service: worker
+`,
+		"common.yml": `web:
+  image: ${COMPOSE_TEST_IMAGE}
+`,
+		"plain.yml": `worker:
+  image: convox/worker
+`,
+	})
+	defer os.RemoveAll(dir)
+
+	files, err := Interpolate(dir, "docker-compose.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{len(files), 1},
+		{string(files["common.yml"]), "web:\n  image: convox/web\n"},
+	}
+
+	_assert(t, cases)
+}
+
+func TestValidateRejectsUnsupported(t *testing.T) {
+	validate := func(yml string) string {
+		dir := writeManifestDir(t, map[string]string{"docker-compose.yml": yml})
+		defer os.RemoveAll(dir)
+
+		_, err := Read(dir, "docker-compose.yml")
+		if err == nil {
+			return ""
+		}
+		return err.Error()
+	}
+
+	cases := Cases{
+		{validate("web:\n  image: httpd\n  healthcheck:\n    test: curl localhost\n"), "web: healthcheck is not supported, the rack checks processes through their load balancer ports"},
+		{validate("web:\n  image: httpd\n  restart: \"no\"\n"), `web: restart policy "no" is not supported, processes are always restarted (use "always" or "unless-stopped")`},
+		{validate("web:\n  image: httpd\n  mem_limit: lots\n"), "web: invalid mem_limit: invalid size: 'lots'"},
+		{validate("web:\n  image: httpd\n  depends_on:\n    - db\n"), "web: depends_on unknown service db"},
+		{validate("web:\n  extends: app\n"), "web extends unknown service app"},
+	}
+
+	_assert(t, cases)
+}
@@ -0,0 +1,158 @@
+package manifest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fsouza/go-dockerclient"
+)
+
+// DockerClient is the subset of the Docker Engine API used to build and run a manifest
+type DockerClient interface {
+	AddEventListener(listener chan<- *docker.APIEvents) error
+	AttachToContainer(opts docker.AttachToContainerOptions) error
+	CreateContainer(opts docker.CreateContainerOptions) (*docker.Container, error)
+	InspectContainer(id string) (*docker.Container, error)
+	InspectImage(name string) (*docker.Image, error)
+	KillContainer(opts docker.KillContainerOptions) error
+	RemoveContainer(opts docker.RemoveContainerOptions) error
+	RemoveEventListener(listener chan *docker.APIEvents) error
+	StartContainer(id string, hostConfig *docker.HostConfig) error
+	TagImage(name string, opts docker.TagImageOptions) error
+	Version() (*docker.Env, error)
+	WaitContainer(id string) (int, error)
+}
+
+// NOTE: this var allows us to replace the Docker Engine API during testing
+var Docker = func() (DockerClient, error) {
+	return docker.NewClientFromEnv()
+}
+
+// ReadyTimeout is how long to wait for a started container to report as running
+var ReadyTimeout = 30 * time.Second
+
+// ContainerExitError is returned when a process container exits unsuccessfully
+type ContainerExitError struct {
+	Process string
+	Code    int
+}
+
+func (e *ContainerExitError) Error() string {
+	return fmt.Sprintf("%s exited with code %d", e.Process, e.Code)
+}
+
+// ContainerStartError is returned when a process container can not be started
+type ContainerStartError struct {
+	Process string
+	Err     error
+}
+
+func (e *ContainerStartError) Error() string {
+	return fmt.Sprintf("unable to start %s: %s", e.Process, e.Err)
+}
+
+// containerIP waits for the start event of a container and returns its address.
+// The listener must be registered before the container is started.
+func containerIP(dc DockerClient, events chan *docker.APIEvents, id string) (string, error) {
+	timeout := time.After(ReadyTimeout)
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return "", fmt.Errorf("event stream closed")
+			}
+
+			if event.ID != id {
+				continue
+			}
+
+			switch event.Status {
+			case "start":
+				c, err := dc.InspectContainer(id)
+				if err != nil {
+					return "", err
+				}
+
+				return c.NetworkSettings.IPAddress, nil
+			case "die":
+				return "", fmt.Errorf("container exited during startup")
+			}
+		case <-timeout:
+			// the event stream may be unavailable, trust the container state
+			c, err := dc.InspectContainer(id)
+			if err != nil {
+				return "", err
+			}
+
+			if !c.State.Running {
+				return "", fmt.Errorf("timeout waiting for container to start")
+			}
+
+			return c.NetworkSettings.IPAddress, nil
+		}
+	}
+}
+
+// removeEvents unregisters an event listener, draining events that are
+// in flight so the client can not block delivering them
+func removeEvents(dc DockerClient, events chan *docker.APIEvents) {
+	done := make(chan bool)
+
+	go func() {
+		for {
+			select {
+			case <-events:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	dc.RemoveEventListener(events)
+
+	close(done)
+}
+
+// cacheFromVersion is the Docker API version of Docker 1.13, which added docker build --cache-from
+var cacheFromVersion, _ = docker.NewAPIVersion("1.25")
+
+// cacheFromSupported returns true when both the docker daemon and the docker cli used for
+// builds know docker build --cache-from
+func cacheFromSupported(dc DockerClient) bool {
+	env, err := dc.Version()
+	if err != nil {
+		return false
+	}
+
+	version, err := docker.NewAPIVersion(env.Get("ApiVersion"))
+	if err != nil || version.LessThan(cacheFromVersion) {
+		return false
+	}
+
+	help, err := Execer("docker", "build", "--help").CombinedOutput()
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(string(help), "--cache-from")
+}
+
+// imageExists returns true if an image is available locally
+func imageExists(dc DockerClient, image string) bool {
+	_, err := dc.InspectImage(image)
+	return err == nil
+}
+
+// tagImage tags an image with a repository and optional tag, i.e. app/web
+func tagImage(dc DockerClient, from, to string) error {
+	repo, tag := docker.ParseRepositoryTag(to)
+
+	Stdout.Write([]byte(fmt.Sprintf("TAGGING: %s %s\n", from, to)))
+
+	return dc.TagImage(from, docker.TagImageOptions{
+		Repo: repo,
+		Tag:  tag,
+	})
+}
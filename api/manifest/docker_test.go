@@ -0,0 +1,245 @@
+package manifest
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fsouza/go-dockerclient"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// fakeDocker stands in for the Docker Engine API, starting containers that
+// print a line of output and exit with the configured code
+type fakeDocker struct {
+	sync.Mutex
+
+	APIVersion string
+	Codes      map[string]int
+	Env        []string
+	Output     string
+
+	created   []docker.CreateContainerOptions
+	killed    []string
+	listeners []chan<- *docker.APIEvents
+	tags      []string
+}
+
+func (f *fakeDocker) AddEventListener(listener chan<- *docker.APIEvents) error {
+	f.Lock()
+	defer f.Unlock()
+	f.listeners = append(f.listeners, listener)
+	return nil
+}
+
+func (f *fakeDocker) AttachToContainer(opts docker.AttachToContainerOptions) error {
+	opts.Success <- struct{}{}
+	<-opts.Success
+	fmt.Fprintf(opts.OutputStream, "%s %s\n", f.Output, opts.Container)
+	return nil
+}
+
+func (f *fakeDocker) CreateContainer(opts docker.CreateContainerOptions) (*docker.Container, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.created = append(f.created, opts)
+	return &docker.Container{ID: opts.Name}, nil
+}
+
+func (f *fakeDocker) InspectContainer(id string) (*docker.Container, error) {
+	return &docker.Container{
+		ID:              id,
+		NetworkSettings: &docker.NetworkSettings{IPAddress: fmt.Sprintf("10.0.0.%d", len(id))},
+		State:           docker.State{Running: true},
+	}, nil
+}
+
+func (f *fakeDocker) InspectImage(name string) (*docker.Image, error) {
+	return &docker.Image{ID: name, Config: &docker.Config{Env: f.Env}}, nil
+}
+
+func (f *fakeDocker) KillContainer(opts docker.KillContainerOptions) error {
+	f.Lock()
+	defer f.Unlock()
+	f.killed = append(f.killed, opts.ID)
+	return nil
+}
+
+func (f *fakeDocker) RemoveContainer(opts docker.RemoveContainerOptions) error {
+	return nil
+}
+
+func (f *fakeDocker) RemoveEventListener(listener chan *docker.APIEvents) error {
+	f.Lock()
+	defer f.Unlock()
+
+	for i, l := range f.listeners {
+		if l == listener {
+			f.listeners = append(f.listeners[:i], f.listeners[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeDocker) StartContainer(id string, hostConfig *docker.HostConfig) error {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, l := range f.listeners {
+		l <- &docker.APIEvents{ID: id, Status: "start"}
+	}
+
+	return nil
+}
+
+func (f *fakeDocker) TagImage(name string, opts docker.TagImageOptions) error {
+	f.Lock()
+	defer f.Unlock()
+	f.tags = append(f.tags, fmt.Sprintf("%s %s", name, opts.Repo))
+	return nil
+}
+
+func (f *fakeDocker) Version() (*docker.Env, error) {
+	return &docker.Env{"ApiVersion=" + f.APIVersion}, nil
+}
+
+func (f *fakeDocker) WaitContainer(id string) (int, error) {
+	return f.Codes[id], nil
+}
+
+func stubDocker(f *fakeDocker) func() {
+	Docker = func() (DockerClient, error) {
+		return f, nil
+	}
+
+	return func() {
+		Docker = func() (DockerClient, error) {
+			return docker.NewClientFromEnv()
+		}
+	}
+}
+
+func TestRunDocker(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  image: httpd
+  command: ruby web.rb
+  links:
+    - postgres
+  ports:
+    - 5000:3000
+postgres:
+  image: convox/postgres
+  ports:
+    - 5432
+`)
+
+	_ = yaml.Unmarshal(data, &m)
+
+	f := &fakeDocker{Env: []string{"LINK_USERNAME=postgres"}, Output: "hello from"}
+	defer stubDocker(f)()
+
+	Execer = func(bin string, args ...string) *exec.Cmd {
+		return exec.Command("true")
+	}
+	defer func() { Execer = exec.Command }()
+
+	var errs []error
+
+	stdout, _ := testRunner(&m, "app", func() {
+		errs = m.Run("app", RunOptions{Cache: true})
+	})
+
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	if len(f.created) != 2 || f.created[0].Name != "app-postgres" || f.created[1].Name != "app-web" {
+		t.Fatalf("expected postgres to start before web, got: %+v", f.created)
+	}
+
+	web := f.created[1]
+
+	cases := Cases{
+		{web.Config.Image, "app/web"},
+		{web.Config.Cmd, []string{"sh", "-c", "ruby web.rb"}},
+		{web.HostConfig.ExtraHosts, []string{"app-postgres:10.0.0.12"}},
+		{web.HostConfig.PortBindings["3000/tcp"], []docker.PortBinding{{HostPort: "5000"}}},
+	}
+
+	_assert(t, cases)
+
+	env := strings.Join(web.Config.Env, " ")
+
+	if !strings.Contains(env, "POSTGRES_URL=tcp://postgres:@app-postgres:5432") {
+		t.Errorf("expected link environment, got: %s", env)
+	}
+
+	if !strings.Contains(stdout, "linking app-postgres as POSTGRES_URL") || strings.Contains(stdout, "tcp://postgres") {
+		t.Errorf("expected link variable name without its value, got: %s", stdout)
+	}
+
+	if !strings.Contains(stdout, "hello from app-web") || !strings.Contains(stdout, "hello from app-postgres") {
+		t.Errorf("expected container output, got: %s", stdout)
+	}
+}
+
+func TestRunDockerExitCode(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  image: httpd
+`)
+
+	_ = yaml.Unmarshal(data, &m)
+
+	f := &fakeDocker{Codes: map[string]int{"app-web": 3}}
+	defer stubDocker(f)()
+
+	var errs []error
+
+	testRunner(&m, "app", func() {
+		errs = m.Run("app", RunOptions{Cache: true})
+	})
+
+	if len(errs) != 1 {
+		t.Fatalf("expected an exit error, got: %v", errs)
+	}
+
+	if err, ok := errs[0].(*ContainerExitError); !ok || err.Code != 3 || err.Process != "web" {
+		t.Errorf("expected exit code 3 from web, got: %#v", errs[0])
+	}
+}
+
+func TestBuildTagsImages(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  image: httpd
+worker:
+  image: httpd
+`)
+
+	_ = yaml.Unmarshal(data, &m)
+
+	f := &fakeDocker{}
+	defer stubDocker(f)()
+
+	Execer = func(bin string, args ...string) *exec.Cmd {
+		return exec.Command("true")
+	}
+	defer func() { Execer = exec.Command }()
+
+	testRunner(&m, "app", func() {
+		m.Build("app", ".", BuildOptions{Cache: true})
+	})
+
+	sort.Strings(f.tags)
+
+	_assert(t, Cases{
+		{f.tags, []string{"httpd app/web", "httpd app/worker"}},
+	})
+}
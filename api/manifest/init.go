@@ -0,0 +1,152 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/convox/rack/cmd/convox/templates"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var reDjangoSettings = regexp.MustCompile(`DJANGO_SETTINGS_MODULE["']\s*,\s*["']([\w.]+)\.settings["']`)
+
+// webDefaults returns the ports and labels the init template for a kind gives its web
+// process, falling back to the ports of the convox ruby images
+func webDefaults(template string) ([]string, []string, error) {
+	ports := []string{"80:4000", "443:4001"}
+	labels := []string{"convox.port.443.protocol=tls", "convox.port.443.proxy=true"}
+
+	data, err := templates.Asset(template)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m Manifest
+
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, nil, err
+	}
+
+	web, ok := m["web"]
+
+	if !ok {
+		return ports, labels, nil
+	}
+
+	return stringValues(web.Ports), stringValues(web.Labels), nil
+}
+
+// conventionalProcfile returns the processes an application runs by the conventions of
+// its framework when it has no Procfile. Kinds whose Dockerfile already starts the
+// application return no processes so the default manifest for the kind is used.
+func conventionalProcfile(dir, kind string) Procfile {
+	switch kind {
+	case "node":
+		return nodeProcfile(dir)
+	case "python":
+		return pythonProcfile(dir)
+	}
+
+	return nil
+}
+
+// nodeProcfile uses the start and worker scripts from package.json
+func nodeProcfile(dir string) Procfile {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "package.json"))
+
+	if err != nil {
+		return nil
+	}
+
+	var pkg struct {
+		Main    string            `json:"main"`
+		Scripts map[string]string `json:"scripts"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil
+	}
+
+	pf := Procfile{}
+
+	switch {
+	case pkg.Scripts["start"] != "", exists(filepath.Join(dir, "server.js")):
+		pf = append(pf, ProcfileEntry{Name: "web", Command: "npm start"})
+	case pkg.Main != "":
+		pf = append(pf, ProcfileEntry{Name: "web", Command: fmt.Sprintf("node %s", pkg.Main)})
+	}
+
+	if pkg.Scripts["worker"] != "" {
+		pf = append(pf, ProcfileEntry{Name: "worker", Command: "npm run worker"})
+	}
+
+	return pf
+}
+
+// pythonProcfile recognizes django and flask applications, serving them with gunicorn
+// when it is a dependency, and celery workers
+func pythonProcfile(dir string) Procfile {
+	deps := ""
+
+	for _, file := range []string{"requirements.txt", "Pipfile"} {
+		if data, err := ioutil.ReadFile(filepath.Join(dir, file)); err == nil {
+			deps += strings.ToLower(string(data))
+		}
+	}
+
+	gunicorn := strings.Contains(deps, "gunicorn")
+
+	pf := Procfile{}
+	module := ""
+
+	if data, err := ioutil.ReadFile(filepath.Join(dir, "manage.py")); err == nil {
+		if m := reDjangoSettings.FindStringSubmatch(string(data)); len(m) == 2 {
+			module = m[1]
+		}
+
+		switch {
+		case gunicorn && module != "":
+			pf = append(pf, ProcfileEntry{Name: "web", Command: fmt.Sprintf("gunicorn %s.wsgi --bind 0.0.0.0:3000", module)})
+		default:
+			pf = append(pf, ProcfileEntry{Name: "web", Command: "python manage.py runserver 0.0.0.0:3000"})
+		}
+	} else if strings.Contains(deps, "flask") {
+		for _, name := range []string{"app", "wsgi", "main"} {
+			if !exists(filepath.Join(dir, name+".py")) {
+				continue
+			}
+
+			if gunicorn {
+				pf = append(pf, ProcfileEntry{Name: "web", Command: fmt.Sprintf("gunicorn %s:app --bind 0.0.0.0:3000", name)})
+			} else {
+				pf = append(pf, ProcfileEntry{Name: "web", Command: fmt.Sprintf("FLASK_APP=%s.py flask run --host 0.0.0.0 --port 3000", name)})
+			}
+
+			module = name
+			break
+		}
+	}
+
+	if strings.Contains(deps, "celery") && module != "" {
+		pf = append(pf, ProcfileEntry{Name: "worker", Command: fmt.Sprintf("celery worker --app %s", module)})
+	}
+
+	return pf
+}
+
+func stringValues(value interface{}) []string {
+	values := []string{}
+
+	if list, ok := value.([]interface{}); ok {
+		for _, v := range list {
+			values = append(values, fmt.Sprintf("%v", v))
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,121 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectApplication(t *testing.T) {
+	detect := func(files ...string) string {
+		contents := map[string]string{}
+
+		for _, f := range files {
+			contents[f] = ""
+		}
+
+		dir := writeManifestDir(t, contents)
+		defer os.RemoveAll(dir)
+
+		return detectApplication(dir)
+	}
+
+	cases := Cases{
+		{detect("Gemfile.lock", "config.ru"), "sinatra"},
+		{detect("Gemfile.lock"), "ruby"},
+		{detect(".meteor", "package.json"), "meteor"},
+		{detect("package.json", "yarn.lock"), "node"},
+		{detect("requirements.txt"), "python"},
+		{detect("Pipfile"), "python"},
+		{detect("go.mod"), "go"},
+		{detect("Godeps"), "go"},
+		{detect("pom.xml"), "maven"},
+		{detect("build.gradle"), "gradle"},
+		{detect("index.html", "style.css"), "static"},
+		{detect("README"), "unknown"},
+	}
+
+	_assert(t, cases)
+}
+
+func TestInitNode(t *testing.T) {
+	dir := writeManifestDir(t, map[string]string{
+		"package.json": `{"name": "app", "scripts": {"start": "node server.js", "worker": "node worker.js"}}`,
+		"yarn.lock":    "",
+	})
+	defer os.RemoveAll(dir)
+
+	if err := Init(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{readFile(t, dir, "docker-compose.yml"), `web:
+  build: .
+  command: npm start
+  labels:
+  - convox.port.443.protocol=tls
+  ports:
+  - 80:3000
+  - 443:3000
+worker:
+  build: .
+  command: npm run worker
+`},
+		{strings.HasPrefix(readFile(t, dir, "Dockerfile"), "FROM node:"), true},
+		{strings.Contains(readFile(t, dir, ".dockerignore"), "/node_modules"), true},
+	}
+
+	_assert(t, cases)
+}
+
+func TestInitDjango(t *testing.T) {
+	dir := writeManifestDir(t, map[string]string{
+		"requirements.txt": "Django==1.11\ngunicorn==19.7\ncelery==4.1\n",
+		"manage.py":        `os.environ.setdefault("DJANGO_SETTINGS_MODULE", "mysite.settings")`,
+	})
+	defer os.RemoveAll(dir)
+
+	if err := Init(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{readFile(t, dir, "docker-compose.yml"), `web:
+  build: .
+  command: gunicorn mysite.wsgi --bind 0.0.0.0:3000
+  labels:
+  - convox.port.443.protocol=tls
+  ports:
+  - 80:3000
+  - 443:3000
+worker:
+  build: .
+  command: celery worker --app mysite
+`},
+	}
+
+	_assert(t, cases)
+}
+
+func TestInitStatic(t *testing.T) {
+	dir := writeManifestDir(t, map[string]string{
+		"index.html": "<h1>hello</h1>",
+	})
+	defer os.RemoveAll(dir)
+
+	if err := Init(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := os.Stat(filepath.Join(dir, ".dockerignore"))
+
+	cases := Cases{
+		{readFile(t, dir, "Dockerfile"), "FROM nginx:alpine\n\nCOPY . /usr/share/nginx/html\n"},
+		{readFile(t, dir, "docker-compose.yml"), "web:\n  build: .\n  labels:\n    - convox.port.443.protocol=tls\n  ports:\n    - 80:80\n    - 443:80\n"},
+		{err, nil},
+	}
+
+	_assert(t, cases)
+}
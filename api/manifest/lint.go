@@ -0,0 +1,370 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// LintIssue is a problem found in a manifest file
+type LintIssue struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Severity string `json:"severity"`
+	Service  string `json:"service,omitempty"`
+	Message  string `json:"message"`
+}
+
+// lintIssues sorts issues by line
+type lintIssues []LintIssue
+
+func (is lintIssues) Len() int           { return len(is) }
+func (is lintIssues) Less(i, j int) bool { return is[i].Line < is[j].Line }
+func (is lintIssues) Swap(i, j int)      { is[i], is[j] = is[j], is[i] }
+
+// problem is an issue with an entry, located in the file by a string on its line
+type problem struct {
+	Service  string
+	Find     string
+	Severity string
+	Message  string
+}
+
+// KnownLabels match the convox labels that are understood by convox start or the rack
+var KnownLabels = []*regexp.Regexp{
+	regexp.MustCompile(`\Aconvox\.cron\.[^.]+\z`),
+	regexp.MustCompile(`\Aconvox\.port\.\d+\.(protocol|proxy|secure)\z`),
+	regexp.MustCompile(`\Aconvox\.service\.(elasticsearch|memcached|mysql|postgres|rabbitmq|redis|s3|sqs)\z`),
+	regexp.MustCompile(`\Aconvox\.start\.(rebuild|shift)\z`),
+}
+
+var reYAMLErrorLine = regexp.MustCompile(`line (\d+)`)
+
+// Lint reads a manifest and returns every problem found in it rather than only the first.
+// Environment and port checks are warnings as they depend on the local machine.
+func Lint(dir, filename string) ([]LintIssue, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, filename))
+	if err != nil {
+		return nil, fmt.Errorf("file not found: %s", filename)
+	}
+
+	lines := manifestLines(strings.Split(string(data), "\n"))
+	issues := []LintIssue{}
+
+	report := func(p problem) {
+		issues = append(issues, LintIssue{
+			File:     filename,
+			Line:     lines.find(p.Service, p.Find),
+			Severity: p.Severity,
+			Service:  p.Service,
+			Message:  p.Message,
+		})
+	}
+
+	if err := loadDotEnv(dir); err != nil {
+		return nil, err
+	}
+
+	yamlIssue := func(err error) []LintIssue {
+		line := 0
+
+		if m := reYAMLErrorLine.FindStringSubmatch(err.Error()); len(m) == 2 {
+			line, _ = strconv.Atoi(m[1])
+		}
+
+		return []LintIssue{{File: filename, Line: line, Severity: SeverityError, Message: err.Error()}}
+	}
+
+	idata, err := interpolateYAML(data)
+	if ye, ok := err.(*YAMLError); ok {
+		return yamlIssue(ye), nil
+	}
+	if err != nil {
+		report(problem{Find: "${", Severity: SeverityError, Message: err.Error()})
+		return issues, nil
+	}
+
+	var mv2 ManifestV2
+	var m Manifest
+
+	if err := yaml.Unmarshal(idata, &mv2); err != nil {
+		return yamlIssue(err), nil
+	}
+
+	if mv2.Version == "" {
+		if err := yaml.Unmarshal(idata, &m); err != nil {
+			return yamlIssue(err), nil
+		}
+	} else {
+		m = mv2.Services
+	}
+
+	for _, name := range m.names() {
+		entry, err := m.extend(dir, name, true, map[string]bool{})
+		if err != nil {
+			report(problem{Service: name, Find: "extends", Severity: SeverityError, Message: err.Error()})
+			return issues, nil
+		}
+
+		if !regexValidProcessName.MatchString(name) {
+			report(problem{Service: name, Severity: SeverityError, Message: fmt.Sprintf("process name %q is invalid. It should contain only alphanumeric characters and dashes.", name)})
+		}
+
+		if err := entry.applyEnvFiles(dir); err != nil {
+			report(problem{Service: name, Find: "env_file", Severity: SeverityError, Message: fmt.Sprintf("%s: %s", name, err)})
+		}
+
+		m[name] = entry
+	}
+
+	for _, p := range m.validationProblems() {
+		report(p)
+	}
+
+	for _, p := range m.lintProblems() {
+		report(p)
+	}
+
+	conflicts, _ := m.PortConflicts(0)
+
+	for _, name := range m.names() {
+		entry := Manifest{name: m[name]}
+
+		for _, port := range entry.PortsWanted(0) {
+			if contains(conflicts, port) {
+				report(problem{Service: name, Find: port + ":", Severity: SeverityWarning, Message: fmt.Sprintf("%s: port %s is already in use", name, port)})
+			}
+		}
+	}
+
+	for _, name := range m.names() {
+		entry := m[name]
+
+		for _, env := range entry.missingEnvironment() {
+			report(problem{Service: name, Find: env, Severity: SeverityWarning, Message: fmt.Sprintf("%s: environment variable %s has no value, set it locally or with convox env set", name, env)})
+		}
+	}
+
+	sort.Stable(lintIssues(issues))
+
+	return issues, nil
+}
+
+// validationProblems are the problems that make Validate, and so Read, fail
+func (m Manifest) validationProblems() []problem {
+	regexValidCronLabel := regexp.MustCompile(`\A[a-zA-Z][-a-zA-Z0-9]{3,29}\z`)
+
+	problems := []problem{}
+
+	add := func(service, find, message string, args ...interface{}) {
+		problems = append(problems, problem{Service: service, Find: find, Severity: SeverityError, Message: fmt.Sprintf(message, args...)})
+	}
+
+	for _, name := range m.names() {
+		entry := m[name]
+
+		if entry.Healthcheck != nil {
+			add(name, "healthcheck", "%s: healthcheck is not supported, the rack checks processes through their load balancer ports", name)
+		}
+
+		if !restartPolicies[entry.Restart] {
+			add(name, "restart", "%s: restart policy %q is not supported, processes are always restarted (use \"always\" or \"unless-stopped\")", name, entry.Restart)
+		}
+
+		if _, err := entry.MemoryLimit(); err != nil {
+			add(name, "mem_limit", "%s: invalid mem_limit: %s", name, err)
+		}
+
+		for _, dep := range entry.DependsOn {
+			if _, ok := m[dep]; !ok {
+				add(name, dep, "%s: depends_on unknown service %s", name, dep)
+			}
+		}
+
+		labels := entry.labelsByPrefix("convox.cron")
+
+		for _, k := range sortedKeys(labels) {
+			parts := strings.Split(k, ".")
+			if len(parts) != 3 {
+				add(name, k, "Cron task is not valid (must be in format convox.cron.myjob)")
+				continue
+			}
+
+			if !regexValidCronLabel.MatchString(parts[2]) {
+				add(name, k, "Cron task %s is not valid (cron names can contain only alphanumeric characters and dashes and must be between 4 and 30 characters)", parts[2])
+			}
+		}
+	}
+
+	return problems
+}
+
+// lintProblems are problems that Read tolerates but that are likely mistakes
+func (m Manifest) lintProblems() []problem {
+	problems := []problem{}
+
+	for _, name := range m.names() {
+		entry := m[name]
+
+		for _, link := range entry.Links {
+			lname := strings.Split(link, ":")[0]
+
+			if _, ok := m[lname]; !ok {
+				problems = append(problems, problem{Service: name, Find: link, Severity: SeverityError, Message: fmt.Sprintf("%s: links to missing service %s", name, lname)})
+			}
+		}
+
+		labels := entry.labelsByPrefix("convox.")
+
+		for _, k := range sortedKeys(labels) {
+			known := false
+
+			for _, re := range KnownLabels {
+				if re.MatchString(k) {
+					known = true
+					break
+				}
+			}
+
+			if !known {
+				problems = append(problems, problem{Service: name, Find: k, Severity: SeverityWarning, Message: fmt.Sprintf("%s: unknown label %s", name, k)})
+			}
+		}
+	}
+
+	return problems
+}
+
+// missingEnvironment returns the variables an entry declares without a value that are
+// neither set locally nor provided by its links. Unlike MissingEnvironment it does not
+// need to inspect the images of linked entries.
+func (me ManifestEntry) missingEnvironment() []string {
+	missing := []string{}
+
+	for _, env := range me.EnvironmentArray() {
+		if strings.Contains(env, "=") {
+			continue
+		}
+
+		if _, ok := os.LookupEnv(env); ok {
+			continue
+		}
+
+		linked := false
+
+		for _, link := range me.Links {
+			prefix := strings.Replace(strings.ToUpper(strings.Split(link, ":")[0]), "-", "_", -1) + "_"
+
+			if strings.HasPrefix(env, prefix) {
+				linked = true
+				break
+			}
+		}
+
+		if !linked {
+			missing = append(missing, env)
+		}
+	}
+
+	return missing
+}
+
+// names returns the sorted names of the entries of a manifest
+func (m Manifest) names() []string {
+	names := []string{}
+
+	for name := range m {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// manifestLines locates problems in the text of a manifest
+type manifestLines []string
+
+// find returns the line number of the first line in the definition of a service that
+// contains s, the line of the service itself if there is none, or 0 when not found
+func (ml manifestLines) find(service, s string) int {
+	if service == "" {
+		if s == "" {
+			return 0
+		}
+
+		for i, line := range ml {
+			if strings.Contains(line, s) {
+				return i + 1
+			}
+		}
+
+		return 0
+	}
+
+	start, indent := ml.service(service)
+	if start < 0 {
+		return 0
+	}
+
+	if s == "" {
+		return start + 1
+	}
+
+	for i := start + 1; i < len(ml); i++ {
+		trimmed := strings.TrimSpace(ml[i])
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if len(ml[i])-len(strings.TrimLeft(ml[i], " \t")) <= indent {
+			break
+		}
+
+		if strings.Contains(ml[i], s) {
+			return i + 1
+		}
+	}
+
+	return start + 1
+}
+
+// service returns the index and indentation of the least indented key for a service
+func (ml manifestLines) service(name string) (int, int) {
+	re := regexp.MustCompile(`\A(\s*)["']?` + regexp.QuoteMeta(name) + `["']?\s*:\s*(#.*)?\z`)
+
+	index, indent := -1, 0
+
+	for i, line := range ml {
+		if m := re.FindStringSubmatch(line); m != nil {
+			if index < 0 || len(m[1]) < indent {
+				index, indent = i, len(m[1])
+			}
+		}
+	}
+
+	return index, indent
+}
@@ -0,0 +1,65 @@
+package manifest
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	os.Unsetenv("LINT_TEST_SECRET")
+
+	dir := writeManifestDir(t, map[string]string{
+		"docker-compose.yml": `version: "2"
+services:
+  web:
+    image: httpd
+    environment:
+      - LINT_TEST_SECRET
+    labels:
+      - convox.port.443.protocol=tls
+      - convox.health.path=/check
+    links:
+      - database
+    restart: "no"
+  worker_1:
+    image: convox/worker
+    depends_on:
+      - queue
+`,
+	})
+	defer os.RemoveAll(dir)
+
+	issues, err := Lint(dir, "docker-compose.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{issues, []LintIssue{
+			{File: "docker-compose.yml", Line: 6, Severity: SeverityWarning, Service: "web", Message: "web: environment variable LINT_TEST_SECRET has no value, set it locally or with convox env set"},
+			{File: "docker-compose.yml", Line: 9, Severity: SeverityWarning, Service: "web", Message: "web: unknown label convox.health.path"},
+			{File: "docker-compose.yml", Line: 11, Severity: SeverityError, Service: "web", Message: "web: links to missing service database"},
+			{File: "docker-compose.yml", Line: 12, Severity: SeverityError, Service: "web", Message: `web: restart policy "no" is not supported, processes are always restarted (use "always" or "unless-stopped")`},
+			{File: "docker-compose.yml", Line: 13, Severity: SeverityError, Service: "worker_1", Message: `process name "worker_1" is invalid. It should contain only alphanumeric characters and dashes.`},
+			{File: "docker-compose.yml", Line: 16, Severity: SeverityError, Service: "worker_1", Message: "worker_1: depends_on unknown service queue"},
+		}},
+	}
+
+	_assert(t, cases)
+}
+
+func TestLintInvalidYAML(t *testing.T) {
+	dir := writeManifestDir(t, map[string]string{
+		"docker-compose.yml": "web:\n  image: httpd\n   ports: [\n",
+	})
+	defer os.RemoveAll(dir)
+
+	issues, err := Lint(dir, "docker-compose.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(issues) != 1 || issues[0].Severity != SeverityError || issues[0].Line != 2 {
+		t.Errorf("unexpected issues: %+v", issues)
+	}
+}
@@ -25,10 +25,9 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/convox/rack/api/fswatch"
 	"github.com/convox/rack/cmd/convox/changes"
 	"github.com/convox/rack/cmd/convox/templates"
-	"github.com/docker/docker/builder/dockerignore"
-	"github.com/docker/docker/pkg/fileutils"
 	"github.com/fatih/color"
 	"github.com/fsouza/go-dockerclient"
 	yaml "gopkg.in/yaml.v2"
@@ -86,18 +85,150 @@ type ManifestV2 struct {
 }
 
 type ManifestEntry struct {
-	Build       string      `yaml:"build,omitempty"`
+	Build       *Build      `yaml:"build,omitempty"`
 	Dockerfile  string      `yaml:"dockerfile,omitempty"`
 	Image       string      `yaml:"image,omitempty"`
 	Command     interface{} `yaml:"command,omitempty"`
+	DependsOn   DependsOn   `yaml:"depends_on,omitempty"`
 	Entrypoint  string      `yaml:"entrypoint,omitempty"`
+	EnvFile     StringList  `yaml:"env_file,omitempty"`
 	Environment interface{} `yaml:"environment,omitempty"`
+	Extends     *Extends    `yaml:"extends,omitempty"`
+	Healthcheck interface{} `yaml:"healthcheck,omitempty"`
 	Labels      interface{} `yaml:"labels,omitempty"`
 	Links       []string    `yaml:"links,omitempty"`
+	MemLimit    interface{} `yaml:"mem_limit,omitempty"`
 	Networks    Networks    `yaml:"networks,omitempty"`
 	Ports       interface{} `yaml:"ports,omitempty"`
 	Privileged  bool        `yaml:"privileged,omitempty"`
+	Restart     string      `yaml:"restart,omitempty"`
+	User        string      `yaml:"user,omitempty"`
 	Volumes     []string    `yaml:"volumes,omitempty"`
+	WorkingDir  string      `yaml:"working_dir,omitempty"`
+}
+
+// Build describes how to build the image for a manifest entry. It can be
+// declared as a string holding the build context or as a map with
+// context, dockerfile and args keys
+type Build struct {
+	Context    string            `yaml:"context,omitempty"`
+	Dockerfile string            `yaml:"dockerfile,omitempty"`
+	Args       map[string]string `yaml:"args,omitempty"`
+}
+
+// BuildOptions control how Manifest.Build builds the images for a manifest
+type BuildOptions struct {
+	Cache bool
+
+	// CacheFrom lists registry images to pull and offer to docker build as
+	// cache sources, e.g. the images of the previous release
+	CacheFrom []string
+
+	// Environment supplies values for build args declared without one,
+	// e.g. app build secrets. Values are never written to build output.
+	Environment map[string]string
+}
+
+// RunOptions control how Manifest.Run runs a manifest locally
+type RunOptions struct {
+	Cache bool
+	Shift int
+	Sync  bool
+
+	// Ports holds the host ports allocated for proxied ports. Without it they are random.
+	Ports *PortState
+
+	// Watch rebuilds and restarts a service when files that affect its image change.
+	// Dir and Environment are used for the rebuild like the arguments of Build.
+	Watch       bool
+	Dir         string
+	Environment map[string]string
+}
+
+// UnmarshalYAML implements the Unmarshaller interface.
+func (b *Build) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	if context, ok := value.(string); ok {
+		b.Context = context
+		return nil
+	}
+
+	section, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return fmt.Errorf("build must be a string or a map")
+	}
+
+	b.Context = "."
+	b.Args = map[string]string{}
+
+	if context, ok := section["context"].(string); ok {
+		b.Context = context
+	}
+
+	if dockerfile, ok := section["dockerfile"].(string); ok {
+		b.Dockerfile = dockerfile
+	}
+
+	switch t := section["args"].(type) {
+	case nil:
+	case map[interface{}]interface{}:
+		for k, v := range t {
+			if v == nil {
+				b.Args[fmt.Sprintf("%v", k)] = ""
+			} else {
+				b.Args[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+			}
+		}
+	case []interface{}:
+		for _, arg := range t {
+			parts := strings.SplitN(fmt.Sprintf("%v", arg), "=", 2)
+
+			if len(parts) == 2 {
+				b.Args[parts[0]] = parts[1]
+			} else {
+				b.Args[parts[0]] = ""
+			}
+		}
+	default:
+		return fmt.Errorf("build args must be a map or a list")
+	}
+
+	return nil
+}
+
+// MarshalYAML implements the Marshaller interface.
+// A build with only a context is written in the short string form.
+func (b Build) MarshalYAML() (interface{}, error) {
+	if b.Dockerfile == "" && len(b.Args) == 0 {
+		return b.Context, nil
+	}
+
+	return struct {
+		Context    string            `yaml:"context,omitempty"`
+		Dockerfile string            `yaml:"dockerfile,omitempty"`
+		Args       map[string]string `yaml:"args,omitempty"`
+	}{b.Context, b.Dockerfile, b.Args}, nil
+}
+
+// ResolvedArgs returns the build args for this build. Args declared without
+// a value are looked up in env.
+func (b Build) ResolvedArgs(env map[string]string) map[string]string {
+	args := map[string]string{}
+
+	for key, value := range b.Args {
+		if value == "" {
+			value = env[key]
+		}
+
+		args[key] = value
+	}
+
+	return args
 }
 
 func init() {
@@ -108,12 +239,35 @@ func Init(dir string) error {
 	return initApplication(dir)
 }
 
+// Read parses a manifest as written. The builder reads manifests this way so its own
+// environment never ends up in an app.
 func Read(dir, filename string) (*Manifest, error) {
+	return read(dir, filename, false)
+}
+
+// ReadInterpolated parses a manifest after substituting variables from the environment
+// and the .env file of dir. Only the cli reads manifests this way.
+func ReadInterpolated(dir, filename string) (*Manifest, error) {
+	if err := loadDotEnv(dir); err != nil {
+		return nil, err
+	}
+
+	return read(dir, filename, true)
+}
+
+func read(dir, filename string, interpolated bool) (*Manifest, error) {
 	data, err := ioutil.ReadFile(filepath.Join(dir, filename))
 	if err != nil {
 		return nil, fmt.Errorf("file not found: %s", filename)
 	}
 
+	if interpolated {
+		data, err = interpolateYAML(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var mv2 ManifestV2
 	var m Manifest
 	var n Networks
@@ -133,28 +287,8 @@ func Read(dir, filename string) (*Manifest, error) {
 		n = mv2.Networks
 	}
 
-	if denv := filepath.Join(dir, ".env"); exists(denv) {
-		data, err := ioutil.ReadFile(denv)
-		if err != nil {
-			return nil, err
-		}
-
-		scanner := bufio.NewScanner(bytes.NewReader(data))
-
-		for scanner.Scan() {
-			if strings.Index(scanner.Text(), "=") > -1 {
-				parts := strings.SplitN(scanner.Text(), "=", 2)
-
-				err := os.Setenv(parts[0], parts[1])
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
-
-		if err := scanner.Err(); err != nil {
-			return nil, err
-		}
+	if err := m.resolveExtends(dir, interpolated); err != nil {
+		return nil, err
 	}
 
 	for name, entry := range m {
@@ -174,6 +308,10 @@ func Read(dir, filename string) (*Manifest, error) {
 			entry.Volumes[i] = strings.Join(parts, ":")
 		}
 
+		if err := entry.applyEnvFiles(dir); err != nil {
+			return nil, err
+		}
+
 		entry.Networks = n
 		m[name] = entry
 	}
@@ -188,46 +326,43 @@ func Read(dir, filename string) (*Manifest, error) {
 
 // Validate convox-specific convox labels and values for every entry
 func (m Manifest) Validate() error {
-	regexValidCronLabel := regexp.MustCompile(`\A[a-zA-Z][-a-zA-Z0-9]{3,29}\z`)
-
-	for _, entry := range map[string]ManifestEntry(m) {
-		labels := entry.labelsByPrefix("convox.cron")
-		for k := range labels {
-			parts := strings.Split(k, ".")
-			if len(parts) != 3 {
-				return fmt.Errorf(
-					"Cron task is not valid (must be in format convox.cron.myjob)",
-				)
-			}
-			name := parts[2]
-			if !regexValidCronLabel.MatchString(name) {
-				return fmt.Errorf(
-					"Cron task %s is not valid (cron names can contain only alphanumeric characters and dashes and must be between 4 and 30 characters)",
-					name,
-				)
-			}
-		}
+	if problems := m.validationProblems(); len(problems) > 0 {
+		return fmt.Errorf("%s", problems[0].Message)
 	}
+
 	return nil
 }
 
-func buildSync(source, tag string, cache bool, dockerfile string) error {
-	args := []string{"build", "-t", tag}
+func buildSync(source, tag string, cache bool, dockerfile string, args map[string]string, cacheFrom []string) error {
+	cmdArgs := []string{"build", "-t", tag}
 
 	// if called with `convox build --no-cache`, assume intent to build from scratch.
 	// So both pull latest images from DockerHub and build without cache
 	if !cache {
-		args = append(args, "--pull")
-		args = append(args, "--no-cache")
+		cmdArgs = append(cmdArgs, "--pull")
+		cmdArgs = append(cmdArgs, "--no-cache")
 	}
 
 	if dockerfile != "" {
-		args = append(args, "-f", filepath.Join(source, dockerfile))
+		cmdArgs = append(cmdArgs, "-f", filepath.Join(source, dockerfile))
+	}
+
+	for _, image := range cacheFrom {
+		cmdArgs = append(cmdArgs, "--cache-from", image)
+	}
+
+	// pass build args by name only so docker reads the values from its
+	// environment and they never show up in the build output
+	env := os.Environ()
+
+	for _, key := range sortedKeys(args) {
+		cmdArgs = append(cmdArgs, "--build-arg", key)
+		env = append(env, fmt.Sprintf("%s=%s", key, args[key]))
 	}
 
-	args = append(args, source)
+	cmdArgs = append(cmdArgs, source)
 
-	return run("docker", args...)
+	return runEnv(env, "docker", cmdArgs...)
 }
 
 func pullSync(image string) error {
@@ -250,8 +385,18 @@ func pushSync(local, remote string) error {
 	return nil
 }
 
-func (m *Manifest) Build(app, dir string, cache bool) []error {
+// Build pulls and builds the images of a manifest and tags them for the app.
+// Builds and pulls use the docker cli so they share its registry credentials
+// and support build args and cache sources.
+func (m *Manifest) Build(app, dir string, opts BuildOptions) []error {
+	dc, err := Docker()
+	if err != nil {
+		return []error{err}
+	}
+
 	builds := map[string]string{}
+	buildArgs := map[string]map[string]string{}
+	buildPaths := map[string]string{}
 	pulls := []string{}
 	tags := map[string]string{}
 
@@ -259,8 +404,8 @@ func (m *Manifest) Build(app, dir string, cache bool) []error {
 		tag := fmt.Sprintf("%s/%s", app, name)
 
 		switch {
-		case entry.Build != "":
-			abs, err := filepath.Abs(filepath.Join(dir, entry.Build))
+		case entry.Build != nil && entry.Build.Context != "":
+			abs, err := filepath.Abs(filepath.Join(dir, entry.Build.Context))
 
 			if err != nil {
 				return []error{err}
@@ -275,18 +420,29 @@ func (m *Manifest) Build(app, dir string, cache bool) []error {
 			if entry.Dockerfile != "" {
 				df = entry.Dockerfile
 			}
+			if entry.Build.Dockerfile != "" {
+				df = entry.Build.Dockerfile
+			}
 
 			sym = filepath.Join(sym, df)
 
-			if _, ok := builds[sym]; !ok {
-				builds[sym] = randomString("convox-", 10)
+			// entries sharing a Dockerfile only share an image when their args match
+			args := entry.Build.ResolvedArgs(opts.Environment)
+			key := sym
+
+			for _, k := range sortedKeys(args) {
+				key += fmt.Sprintf(" %s=%s", k, args[k])
 			}
 
-			tags[tag] = builds[sym]
-		case entry.Image != "":
-			err := Execer("docker", "inspect", entry.Image).Run()
+			if _, ok := builds[key]; !ok {
+				builds[key] = randomString("convox-", 10)
+				buildArgs[key] = args
+				buildPaths[key] = sym
+			}
 
-			if err != nil || !cache {
+			tags[tag] = builds[key]
+		case entry.Image != "":
+			if !imageExists(dc, entry.Image) || !opts.Cache {
 				pulls = append(pulls, entry.Image)
 			}
 
@@ -296,9 +452,26 @@ func (m *Manifest) Build(app, dir string, cache bool) []error {
 
 	errors := []error{}
 
-	for path, tag := range builds {
-		source, dockerfile := filepath.Split(path)
-		err := buildSync(source, tag, cache, dockerfile)
+	// a cache source that can't be pulled only makes the build colder. Cache sources
+	// replace the local layer cache, so only images that were pulled are passed.
+	cacheFrom := []string{}
+
+	if len(builds) > 0 && opts.Cache && len(opts.CacheFrom) > 0 && !cacheFromSupported(dc) {
+		Stdout.Write([]byte("WARNING: docker does not support --cache-from, building without cache images\n"))
+	} else if len(builds) > 0 && opts.Cache {
+		for _, image := range opts.CacheFrom {
+			if err := pullSync(image); err != nil {
+				Stdout.Write([]byte(fmt.Sprintf("WARNING: unable to pull cache image %s: %s\n", image, err)))
+				continue
+			}
+
+			cacheFrom = append(cacheFrom, image)
+		}
+	}
+
+	for key, tag := range builds {
+		source, dockerfile := filepath.Split(buildPaths[key])
+		err := buildSync(source, tag, opts.Cache, dockerfile, buildArgs[key], cacheFrom)
 
 		if err != nil {
 			return []error{err}
@@ -345,10 +518,8 @@ func (m *Manifest) Build(app, dir string, cache bool) []error {
 
 	for _, to := range mk {
 		from := tags[to]
-		// for to, from := range tags {
-		err := run("docker", "tag", from, to)
 
-		if err != nil {
+		if err := tagImage(dc, from, to); err != nil {
 			return []error{err}
 		}
 	}
@@ -551,11 +722,7 @@ func (m *Manifest) Push(app, registry, tag string, flatten string) []error {
 
 	for name, _ := range *m {
 		local := fmt.Sprintf("%s/%s", app, name)
-		remote := fmt.Sprintf("%s/%s-%s:%s", registry, app, name, tag)
-
-		if flatten != "" {
-			remote = fmt.Sprintf("%s/%s:%s", registry, flatten, fmt.Sprintf("%s.%s", name, tag))
-		}
+		remote := pushTag(app, name, registry, tag, flatten)
 
 		var pushErr error
 		var backOff = 1
@@ -583,73 +750,283 @@ func (m *Manifest) Push(app, registry, tag string, flatten string) []error {
 	return []error{}
 }
 
+// Image describes an image pushed to a registry
+type Image struct {
+	Digest string `json:"digest"`
+	Size   int64  `json:"size"`
+}
+
+// Images inspects the images pushed by Push and returns their digests and sizes by process name
+func (m *Manifest) Images(app, registry, tag string, flatten string) (map[string]Image, error) {
+	if tag == "" {
+		tag = "latest"
+	}
+
+	images := map[string]Image{}
+
+	for name := range *m {
+		remote := pushTag(app, name, registry, tag, flatten)
+
+		data, err := Execer("docker", "inspect", "--format", "{{json .RepoDigests}} {{.Size}}", remote).Output()
+		if err != nil {
+			return nil, fmt.Errorf("unable to inspect image %s: %s", remote, err)
+		}
+
+		image, err := parseImageInspect(remote, strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, err
+		}
+
+		images[name] = image
+	}
+
+	return images, nil
+}
+
+// parseImageInspect reads the repo digests and size of an image, keeping
+// the digest from the repository the image was pushed to
+func parseImageInspect(remote, data string) (Image, error) {
+	image := Image{}
+
+	parts := strings.SplitN(data, " ", 2)
+	if len(parts) != 2 {
+		return image, fmt.Errorf("unable to parse image info for %s: %s", remote, data)
+	}
+
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return image, err
+	}
+
+	image.Size = size
+
+	var digests []string
+
+	if err := json.Unmarshal([]byte(parts[0]), &digests); err != nil {
+		return image, err
+	}
+
+	repo := remote
+
+	if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		repo = repo[0:i]
+	}
+
+	for _, d := range digests {
+		if p := strings.SplitN(d, "@", 2); len(p) == 2 && p[0] == repo {
+			image.Digest = p[1]
+		}
+	}
+
+	return image, nil
+}
+
+// pushTag returns the remote tag Push uses for a process image
+func pushTag(app, name, registry, tag, flatten string) string {
+	if flatten != "" {
+		return fmt.Sprintf("%s/%s:%s", registry, flatten, fmt.Sprintf("%s.%s", name, tag))
+	}
+
+	return fmt.Sprintf("%s/%s-%s:%s", registry, app, name, tag)
+}
+
 func (m *Manifest) Raw() ([]byte, error) {
 	return yaml.Marshal(m)
 }
 
-func (m *Manifest) Run(app string, cache, sync bool, shift int) []error {
-	ch := make(chan error)
+// processResult is the outcome of a process container run
+type processResult struct {
+	Process string
+	Err     error
+}
+
+func (m *Manifest) Run(app string, opts RunOptions) []error {
+	dc, err := Docker()
+	if err != nil {
+		return []error{err}
+	}
+
+	order := m.runOrder()
+	prefixes := map[string]string{}
+
+	for i, name := range order {
+		prefixes[name] = m.prefixForEntry(name, i)
+	}
+
+	results := make(chan processResult)
+	alive := map[string]bool{}
+
+	// start runs a process and blocks until its container is running
+	start := func(name string) error {
+		ch := make(chan error)
+		sch := make(chan error)
+
+		go (*m)[name].runAsync(dc, m, prefixes[name], app, name, opts.Cache, opts.Shift, opts.Ports, ch, sch)
+		go func() { results <- processResult{Process: name, Err: <-ch} }()
+
+		alive[name] = true
+
+		return <-sch
+	}
+
 	sigch := make(chan os.Signal, 1)
 	signal.Notify(sigch, os.Interrupt, os.Kill)
 
-	go func() {
-		for _ = range sigch {
-			order := m.runOrder()
+	// processes with a restart policy are not restarted once stopping
+	stopping := make(chan bool)
+	var stopOnce sync.Once
 
-			sort.Sort(sort.Reverse(sort.StringSlice(order)))
+	stop := func() {
+		stopOnce.Do(func() { close(stopping) })
+	}
 
-			for _, name := range order {
-				Execer("docker", "kill", containerName(app, name)).Run()
-			}
+	go func() {
+		for _ = range sigch {
+			stop()
+			m.kill(dc, app, order)
 		}
 	}()
 
-	for i, name := range m.runOrder() {
-		sch := make(chan error)
-		go (*m)[name].runAsync(m, m.prefixForEntry(name, i), app, name, cache, shift, ch, sch)
-		<-sch // block until started successfully
+	running := 0
 
-		if sync {
+	for i, name := range order {
+		running++
+
+		// block until the container is running and has an address for links
+		if err := start(name); err != nil {
+			stop()
+			m.kill(dc, app, order[0:i])
+			break
+		}
+
+		if opts.Sync {
 			time.Sleep(1 * time.Second)
 			m.sync(app, name)
 		}
+	}
 
-		// block until we can get the container IP
-		for {
-			host := containerName(app, name)
-			ip, err := Execer("docker", "inspect", "-f", "{{ .NetworkSettings.IPAddress }}", host).Output()
+	if opts.Sync {
+		go m.syncFiles()
+		go m.syncBack()
+	}
 
-			// dont block in test mode
-			if os.Getenv("PROVIDER") == "test" {
-				break
-			}
+	restarts := make(chan []string)
+	done := make(chan bool)
 
-			if (err == nil) && (net.ParseIP(strings.TrimSpace(string(ip))) != nil) {
-				break
-			}
-			time.Sleep(100 * time.Millisecond)
+	defer close(done)
+
+	if opts.Watch {
+		go m.watch(app, opts, prefixes, restarts, done)
+	}
+
+	errors := []error{}
+	restarting := map[string]bool{}
+	queued := []string{}
+
+	stopped := func() bool {
+		select {
+		case <-stopping:
+			return true
+		default:
+			return false
 		}
 	}
 
-	if sync {
-		go m.syncFiles()
-		go m.syncBack()
+	// restart runs a process again, a new container needs its synced files and its
+	// remote changes watched again
+	restart := func(name string) {
+		if err := start(name); err != nil || !opts.Sync {
+			return
+		}
+
+		time.Sleep(1 * time.Second)
+		m.sync(app, name)
+
+		go m.syncBackProcess(app, name)
 	}
 
-	errors := []error{}
+	// queued processes are started in order once all their old containers have exited
+	// so each one finds the new address of the processes it links to
+	startQueued := func() {
+		for _, name := range queued {
+			if stopped() {
+				running--
+				continue
+			}
 
-	for i := 0; i < len(*m); i++ {
-		if err := <-ch; err != nil {
-			errors = append(errors, err)
+			restart(name)
 		}
+
+		queued = []string{}
 	}
 
-	// err := SignalWaiter(c)
-	// errors = append(errors, err)
+	for running > 0 {
+		select {
+		case r := <-results:
+			alive[r.Process] = false
+
+			// a container killed for a restart is replaced rather than counted
+			if restarting[r.Process] {
+				delete(restarting, r.Process)
+
+				if len(restarting) == 0 {
+					startQueued()
+				}
+
+				continue
+			}
+
+			if (*m)[r.Process].restartOnExit(r.Err, stopping) {
+				fmt.Printf("%s exited, restarting\n", prefixes[r.Process])
+				time.Sleep(1 * time.Second)
+				restart(r.Process)
+				continue
+			}
+
+			running--
+
+			if r.Err != nil {
+				errors = append(errors, r.Err)
+			}
+		case names := <-restarts:
+			if stopped() {
+				continue
+			}
+
+			// merge with a restart still waiting on old containers to exit
+			queue := m.restartOrder(append(queued, names...))
+
+			for _, name := range queue {
+				if restarting[name] || contains(queued, name) {
+					continue
+				}
+
+				if alive[name] {
+					restarting[name] = true
+					dc.KillContainer(docker.KillContainerOptions{ID: containerName(app, name)})
+				} else {
+					running++
+				}
+			}
+
+			queued = queue
+
+			if len(restarting) == 0 {
+				startQueued()
+			}
+		}
+	}
 
 	return errors
 }
 
+// kill stops the containers of the given processes in reverse start order
+func (m *Manifest) kill(dc DockerClient, app string, processes []string) {
+	for i := len(processes) - 1; i >= 0; i-- {
+		dc.KillContainer(docker.KillContainerOptions{ID: containerName(app, processes[i])})
+	}
+}
+
 func (m *Manifest) sync(app, process string) error {
 	err := (*m)[process].syncAdds(app, process)
 
@@ -662,7 +1039,7 @@ func (m *Manifest) sync(app, process string) error {
 
 func (me ManifestEntry) copyChangesBinaryToContainer(app, process string) error {
 	// only sync containers with a build directive
-	if me.Build == "" {
+	if me.Build == nil {
 		return nil
 	}
 
@@ -772,8 +1149,8 @@ func (m *Manifest) runOrder() []string {
 
 			resolved := true
 
-			for _, link := range (*m)[name].Links {
-				lname := strings.Split(link, ":")[0]
+			for _, link := range (*m)[name].dependencies() {
+				lname := link
 
 				found := false
 
@@ -798,118 +1175,196 @@ func (m *Manifest) runOrder() []string {
 	return sorted
 }
 
+// Subset returns the named entries and the entries they link to or depend on, transitively,
+// leaving out the excluded entries. No names selects every entry. It is an error
+// for a selected entry to link to an excluded or unknown one.
+func (m *Manifest) Subset(names, exclude []string) (*Manifest, error) {
+	excluded := map[string]bool{}
+
+	for _, name := range exclude {
+		if _, ok := (*m)[name]; !ok {
+			return nil, fmt.Errorf("no such process: %s", name)
+		}
+
+		excluded[name] = true
+	}
+
+	if len(names) == 0 {
+		for name := range *m {
+			if !excluded[name] {
+				names = append(names, name)
+			}
+		}
+	}
+
+	sub := Manifest{}
+	queue := []string{}
+
+	for _, name := range names {
+		if _, ok := (*m)[name]; !ok {
+			return nil, fmt.Errorf("no such process: %s", name)
+		}
+
+		if excluded[name] {
+			return nil, fmt.Errorf("process %s can not be both started and excluded", name)
+		}
+
+		queue = append(queue, name)
+	}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if _, ok := sub[name]; ok {
+			continue
+		}
+
+		entry := (*m)[name]
+		sub[name] = entry
+
+		for _, lname := range entry.dependencies() {
+			if _, ok := (*m)[lname]; !ok {
+				return nil, fmt.Errorf("process %s depends on unknown process %s", name, lname)
+			}
+
+			if excluded[lname] {
+				return nil, fmt.Errorf("process %s depends on %s which is excluded", name, lname)
+			}
+
+			queue = append(queue, lname)
+		}
+	}
+
+	return &sub, nil
+}
+
+// dependencies returns the names of the entries an entry links to or depends on
+func (me ManifestEntry) dependencies() []string {
+	deps := []string{}
+
+	for _, link := range me.Links {
+		deps = append(deps, strings.Split(link, ":")[0])
+	}
+
+	return append(deps, me.DependsOn...)
+}
+
 func containerName(app, process string) string {
 	return fmt.Sprintf("%s-%s", app, process)
 }
 
-func (me ManifestEntry) runAsync(m *Manifest, prefix, app, process string, cache bool, shift int, ch chan error, sch chan error) {
+// runAsync creates, attaches to and starts the container for a process. It sends on sch once
+// the container is running or failed to start, and on ch when the container is done.
+func (me ManifestEntry) runAsync(dc DockerClient, m *Manifest, prefix, app, process string, cache bool, shift int, ports *PortState, ch chan error, sch chan error) {
 	tag := fmt.Sprintf("%s/%s", app, process)
 	name := containerName(app, process)
 
-	query("docker", "rm", "-f", name)
+	fail := func(err error) {
+		err = &ContainerStartError{Process: process, Err: err}
+		sch <- err
+		ch <- err
+	}
 
-	args := []string{"run", "-i", "--name", name}
+	dc.RemoveContainer(docker.RemoveContainerOptions{ID: name, Force: true})
 
 	resolved, err := me.ResolvedEnvironment(m, cache, app)
-
 	if err != nil {
-		ch <- err
+		fail(err)
 		return
 	}
 
-	for _, env := range resolved {
-		args = append(args, "-e", env)
-	}
-
-	if me.Privileged {
-		args = append(args, "--privileged")
+	memory, err := me.MemoryLimit()
+	if err != nil {
+		fail(err)
+		return
 	}
 
-	ports := []string{}
-
-	switch t := me.Ports.(type) {
-	case []string:
-		for _, port := range t {
-			ports = append(ports, port)
-		}
-	case []interface{}:
-		for _, port := range t {
-			ports = append(ports, fmt.Sprintf("%v", port))
-		}
+	config := &docker.Config{
+		AttachStderr: true,
+		AttachStdout: true,
+		Env:          resolved,
+		ExposedPorts: map[docker.Port]struct{}{},
+		Image:        tag,
+		Memory:       memory,
+		OpenStdin:    true,
+		User:         me.User,
+		WorkingDir:   me.WorkingDir,
 	}
 
-	if s := me.Label("convox.start.shift"); s != "" {
-		si, err := strconv.Atoi(s)
-
-		if err != nil {
-			ch <- err
-			return
-		}
-
-		shift = si
+	hc := &docker.HostConfig{
+		Memory:       memory,
+		PortBindings: map[docker.Port][]docker.PortBinding{},
+		Privileged:   me.Privileged,
 	}
 
-	host := ""
-	container := ""
-
-	for _, port := range ports {
-		switch len(strings.Split(port, ":")) {
-		case 1:
-			host = port
-			container = port
-		case 2:
-			parts := strings.SplitN(port, ":", 2)
-			host = parts[0]
-			container = parts[1]
-		default:
-			ch <- fmt.Errorf("unknown port declaration: %s", port)
-			return
-		}
+	mappings, err := me.portMappings(shift)
+	if err != nil {
+		fail(err)
+		return
+	}
 
-		hosti, err := strconv.Atoi(host)
+	for _, pm := range mappings {
+		host := pm.Host
+		container := pm.Container
 
-		if err != nil {
-			ch <- err
-			return
+		if host == "" {
+			if p := ports.publishedPort(process, container); p > 0 {
+				host = strconv.Itoa(p)
+			}
 		}
 
-		host = fmt.Sprintf("%d", hosti+shift)
-
-		switch proto := me.Label(fmt.Sprintf("convox.port.%s.protocol", host)); proto {
+		switch proto := me.Label(fmt.Sprintf("convox.port.%s.protocol", pm.Declared)); proto {
 		case "https", "tls":
 			proxy := false
 			secure := false
 
-			if me.Label(fmt.Sprintf("convox.port.%s.proxy", host)) == "true" {
+			if me.Label(fmt.Sprintf("convox.port.%s.proxy", pm.Declared)) == "true" {
 				proxy = true
 			}
 
-			if me.Label(fmt.Sprintf("convox.port.%s.secure", host)) == "true" {
+			if me.Label(fmt.Sprintf("convox.port.%s.secure", pm.Declared)) == "true" {
 				secure = true
 			}
 
-			rnd := RandomPort()
 			fmt.Println(prefix, special(fmt.Sprintf("%s proxy enabled for %s:%s", proto, host, container)))
 			go proxyPort(proto, host, container, name, proxy, secure)
-			host = strconv.Itoa(rnd)
+			host = strconv.Itoa(ports.proxyPort(process, host))
+		}
+
+		cp := docker.Port(container)
+
+		if !strings.Contains(container, "/") {
+			cp = docker.Port(container + "/tcp")
 		}
 
-		args = append(args, "-p", fmt.Sprintf("%s:%s", host, container))
+		config.ExposedPorts[cp] = struct{}{}
+		hc.PortBindings[cp] = append(hc.PortBindings[cp], docker.PortBinding{HostPort: host})
 	}
 
 	for _, volume := range me.Volumes {
 		warnIfRoot(volume)
-		args = append(args, "-v", volume)
+
+		// a volume without a host path is anonymous
+		if strings.Contains(volume, ":") {
+			hc.Binds = append(hc.Binds, volume)
+		} else {
+			if config.Volumes == nil {
+				config.Volumes = map[string]struct{}{}
+			}
+
+			config.Volumes[volume] = struct{}{}
+		}
 	}
 
 	if me.Entrypoint != "" {
-		args = append(args, "--entrypoint", me.Entrypoint)
+		config.Entrypoint = []string{me.Entrypoint}
 	}
 
 	if me.Networks != nil {
 		for _, n := range me.Networks {
 			for _, in := range n {
-				args = append(args, "--net", in.Name)
+				hc.NetworkMode = in.Name
 			}
 		}
 	} else {
@@ -917,29 +1372,125 @@ func (me ManifestEntry) runAsync(m *Manifest, prefix, app, process string, cache
 		for _, link := range me.Links {
 			host := containerName(app, link)
 
-			ip, err := Execer("docker", "inspect", "-f", "{{ .NetworkSettings.IPAddress }}", host).CombinedOutput()
-
+			lc, err := dc.InspectContainer(host)
 			if err != nil {
-				ch <- err
+				fail(err)
 				return
 			}
 
-			args = append(args, fmt.Sprintf(`--add-host=%s:%s`, host, strings.TrimSpace(string(ip))))
+			hc.ExtraHosts = append(hc.ExtraHosts, fmt.Sprintf("%s:%s", host, lc.NetworkSettings.IPAddress))
 		}
 	}
 
-	args = append(args, tag)
-
 	switch cmd := me.Command.(type) {
 	case string:
 		if cmd != "" {
-			args = append(args, "sh", "-c", cmd)
+			config.Cmd = []string{"sh", "-c", cmd}
 		}
 	case []string:
-		args = append(args, cmd...)
+		config.Cmd = cmd
+	}
+
+	fmt.Println(prefix, command(fmt.Sprintf("running %s as %s", tag, name)))
+
+	// only the names of link variables are shown, their values can hold credentials
+	for _, link := range me.Links {
+		key := strings.Replace(strings.ToUpper(link), "-", "_", -1) + "_URL"
+
+		for _, env := range resolved {
+			if strings.HasPrefix(env, key+"=") {
+				fmt.Println(prefix, command(fmt.Sprintf("linking %s as %s", containerName(app, link), key)))
+			}
+		}
+	}
+
+	c, err := dc.CreateContainer(docker.CreateContainerOptions{
+		Name:       name,
+		Config:     config,
+		HostConfig: hc,
+	})
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	// attach before starting so no output is lost
+	stdout, stdoutw := io.Pipe()
+	stderr, stderrw := io.Pipe()
+
+	och := make(chan error, 4)
+
+	go outputWithPrefix(prefix, stdout, och)
+	go outputWithPrefix(prefix, stderr, och)
+
+	attached := make(chan struct{})
+	ach := make(chan error, 1)
+
+	go func() {
+		ach <- dc.AttachToContainer(docker.AttachToContainerOptions{
+			Container:    c.ID,
+			OutputStream: stdoutw,
+			ErrorStream:  stderrw,
+			Stream:       true,
+			Stdout:       true,
+			Stderr:       true,
+			Success:      attached,
+		})
+
+		stdoutw.Close()
+		stderrw.Close()
+	}()
+
+	select {
+	case <-attached:
+		attached <- struct{}{}
+	case err := <-ach:
+		fail(err)
+		return
+	}
+
+	events := make(chan *docker.APIEvents, 10)
+
+	if err := dc.AddEventListener(events); err != nil {
+		fail(err)
+		return
+	}
+
+	if err := dc.StartContainer(c.ID, nil); err != nil {
+		removeEvents(dc, events)
+		fail(err)
+		return
+	}
+
+	_, err = containerIP(dc, events, c.ID)
+
+	removeEvents(dc, events)
+
+	if err != nil {
+		dc.KillContainer(docker.KillContainerOptions{ID: c.ID})
+		fail(err)
+		return
+	}
+
+	sch <- nil // signal that start worked
+
+	code, err := dc.WaitContainer(c.ID)
+
+	// drain remaining output
+	<-och
+	<-och
+
+	if err != nil {
+		ch <- err
+		return
+	}
+
+	if code != 0 {
+		ch <- &ContainerExitError{Process: process, Code: code}
+		return
 	}
 
-	ch <- runPrefix(prefix, sch, "docker", args...)
+	ch <- nil
 }
 
 func (me ManifestEntry) Label(key string) string {
@@ -992,10 +1543,13 @@ func (me ManifestEntry) labelsByPrefix(prefix string) map[string]string {
 				continue
 			}
 
-			vs, ok := v.(string)
+			// a label declared without a value is empty
+			vs := ""
 
-			if !ok {
-				continue
+			if v != nil {
+				if vs, ok = v.(string); !ok {
+					continue
+				}
 			}
 
 			if strings.HasPrefix(ks, prefix) {
@@ -1010,10 +1564,14 @@ func (me ManifestEntry) labelsByPrefix(prefix string) map[string]string {
 				continue
 			}
 
-			if parts := strings.SplitN(ls, "=", 2); len(parts) == 2 {
-				if strings.HasPrefix(parts[0], prefix) {
-					returnLabels[parts[0]] = parts[1]
-				}
+			parts := strings.SplitN(ls, "=", 2)
+
+			if len(parts) == 1 {
+				parts = append(parts, "")
+			}
+
+			if strings.HasPrefix(parts[0], prefix) {
+				returnLabels[parts[0]] = parts[1]
 			}
 		}
 	}
@@ -1032,7 +1590,7 @@ func (me ManifestEntry) Protocol(port string) string {
 
 func (me ManifestEntry) syncAdds(app, process string) error {
 	// only sync containers with a build directive
-	if me.Build == "" {
+	if me.Build == nil {
 		return nil
 	}
 
@@ -1042,12 +1600,16 @@ func (me ManifestEntry) syncAdds(app, process string) error {
 		return err
 	}
 
-	dockerfile := filepath.Join(me.Build, "Dockerfile")
+	dockerfile := filepath.Join(me.Build.Context, "Dockerfile")
 
 	if me.Dockerfile != "" {
 		dockerfile = me.Dockerfile
 	}
 
+	if me.Build.Dockerfile != "" {
+		dockerfile = filepath.Join(me.Build.Context, me.Build.Dockerfile)
+	}
+
 	data, err := ioutil.ReadFile(dockerfile)
 
 	if err != nil {
@@ -1102,10 +1664,6 @@ func proxyPort(protocol, from, to, link string, proxy, secure bool) {
 	cmd.Run()
 }
 
-func query(executable string, args ...string) ([]byte, error) {
-	return Execer(executable, args...).CombinedOutput()
-}
-
 func outputWithPrefix(prefix string, r io.Reader, ch chan error) {
 	scanner := bufio.NewScanner(r)
 
@@ -1121,53 +1679,30 @@ func outputWithPrefix(prefix string, r io.Reader, ch chan error) {
 }
 
 func run(executable string, args ...string) error {
+	return runEnv(nil, executable, args...)
+}
+
+// runEnv is like run but executes the command with the given environment
+func runEnv(env []string, executable string, args ...string) error {
 	Stdout.Write([]byte(fmt.Sprintf("RUNNING: %s %s\n", executable, strings.Join(args, " "))))
 
 	cmd := Execer(executable, args...)
+	cmd.Env = env
 	cmd.Stdout = Stdout
 	cmd.Stderr = Stderr
 	return cmd.Run()
 }
 
-func runPrefix(prefix string, sch chan error, executable string, args ...string) error {
-	fmt.Println(prefix, command(fmt.Sprintf("%s %s", executable, strings.Join(args, " "))))
-
-	cmd := Execer(executable, args...)
-
-	stdout, err := cmd.StdoutPipe()
-
-	if err != nil {
-		return err
-	}
-
-	stderr, err := cmd.StderrPipe()
-
-	if err != nil {
-		return err
-	}
-
-	err = cmd.Start()
-
-	if err != nil {
-		return err
-	}
-
-	sch <- nil // signal that start worked
-
-	ch := make(chan error)
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
 
-	go outputWithPrefix(prefix, stdout, ch)
-	go outputWithPrefix(prefix, stderr, ch)
-
-	if err := <-ch; err != nil {
-		return err
+	for key := range m {
+		keys = append(keys, key)
 	}
 
-	if err := <-ch; err != nil {
-		return err
-	}
+	sort.Strings(keys)
 
-	return cmd.Wait()
+	return keys
 }
 
 var randomAlphabet = []rune("abcdefghijklmnopqrstuvwxyz")
@@ -1203,9 +1738,12 @@ func getLinkEntryEnv(linkEntry ManifestEntry, cache bool) (map[string]string, er
 	linkEntryEnv := make(map[string]string)
 
 	if linkEntry.Image != "" {
-		err := Execer("docker", "inspect", linkEntry.Image).Run()
+		dc, err := Docker()
+		if err != nil {
+			return linkEntryEnv, err
+		}
 
-		if err != nil || !cache {
+		if !imageExists(dc, linkEntry.Image) || !cache {
 			pull := Execer("docker", "pull", linkEntry.Image)
 			err := pull.Run()
 			if err != nil {
@@ -1213,32 +1751,17 @@ func getLinkEntryEnv(linkEntry ManifestEntry, cache bool) (map[string]string, er
 			}
 		}
 
-		cmd := Execer("docker", "inspect", linkEntry.Image)
-		output, err := cmd.CombinedOutput()
-
+		image, err := dc.InspectImage(linkEntry.Image)
 		if err != nil {
 			return linkEntryEnv, fmt.Errorf("could not inspect container %q: %s", linkEntry.Image, err.Error())
 		}
 
-		var inspect []struct {
-			Config struct {
-				Env []string
-			}
-		}
-
-		err = json.Unmarshal(output, &inspect)
-		if err != nil {
-			return linkEntryEnv, err
-		}
-
-		if len(inspect) < 1 {
-			return linkEntryEnv, fmt.Errorf("could not inspect container %q", linkEntry.Image)
-		}
-
-		for _, val := range inspect[0].Config.Env {
-			parts := strings.SplitN(val, "=", 2)
-			if len(parts) == 2 {
-				linkEntryEnv[parts[0]] = parts[1]
+		if image.Config != nil {
+			for _, val := range image.Config.Env {
+				parts := strings.SplitN(val, "=", 2)
+				if len(parts) == 2 {
+					linkEntryEnv[parts[0]] = parts[1]
+				}
 			}
 		}
 	}
@@ -1295,52 +1818,6 @@ func getDockerGateway() (string, error) {
 	return host, nil
 }
 
-type Files map[string]time.Time
-
-func watchWalker(files Files, local string, adds map[string]bool, lock sync.Mutex, basePath string, patterns []string, patDirs [][]string, exceptions bool) filepath.WalkFunc {
-	return func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return nil
-		}
-
-		if info.IsDir() {
-			return nil
-		}
-
-		relPath, err := filepath.Rel(basePath, path)
-		if err != nil {
-			return err
-		}
-
-		skip, err := fileutils.OptimizedMatches(relPath, patterns, patDirs)
-		if err != nil {
-			log.Printf("Error matching %s: %v", relPath, err)
-			return err
-		}
-		if skip {
-			return nil
-		}
-
-		if files[path] != info.ModTime() {
-			files[path] = info.ModTime()
-
-			blockLocalAddsLock.Lock()
-
-			if blockLocalAdds[path] > 0 {
-				blockLocalAdds[path] -= 1
-			} else {
-				lock.Lock()
-				adds[path] = true
-				lock.Unlock()
-			}
-
-			blockLocalAddsLock.Unlock()
-		}
-
-		return nil
-	}
-}
-
 func processAdds(prefix string, adds map[string]bool, lock sync.Mutex, syncs []Sync) {
 	dc, _ := docker.NewClientFromEnv()
 
@@ -1492,11 +1969,20 @@ func registerSync(container, local, remote string) error {
 		return err
 	}
 
-	syncs = append(syncs, Sync{
+	sync := Sync{
 		Container: container,
 		Local:     sym,
 		Remote:    remote,
-	})
+	}
+
+	// a restarted container registers its syncs again
+	for _, s := range syncs {
+		if s == sync {
+			return nil
+		}
+	}
+
+	syncs = append(syncs, sync)
 
 	return nil
 }
@@ -1509,41 +1995,81 @@ func (m *Manifest) syncBack() error {
 		containers[sync.Container] = append(containers[sync.Container], sync.Remote)
 	}
 
-	dc, _ := docker.NewClientFromEnv()
+	errch := make(chan error, len(containers))
 
 	for container, dirs := range containers {
-		exec, err := dc.CreateExec(docker.CreateExecOptions{
-			AttachStdin:  false,
-			AttachStdout: true,
-			AttachStderr: true,
-			Cmd:          append([]string{"/changes"}, dirs...),
-			Container:    container,
-		})
+		go func(container string, dirs []string) {
+			errch <- m.syncBackContainer(container, dirs)
+		}(container, dirs)
+	}
 
-		if err != nil {
-			fmt.Printf("err = %+v\n", err)
+	for range containers {
+		if err := <-errch; err != nil {
 			return err
 		}
+	}
 
-		r, w := io.Pipe()
+	return nil
+}
 
-		go m.scanRemoteChanges(container, r)
-		go m.processRemoteChanges(container)
+// syncBackProcess watches a restarted container of a process for remote changes
+func (m *Manifest) syncBackProcess(app, process string) error {
+	container := containerName(app, process)
+	dirs := []string{}
 
-		err = dc.StartExec(exec.ID, docker.StartExecOptions{
-			Tty:          true,
-			RawTerminal:  true,
-			OutputStream: w,
-			ErrorStream:  w,
-		})
+	for _, sync := range syncs {
+		if sync.Container == container {
+			dirs = append(dirs, sync.Remote)
+		}
+	}
 
-		fmt.Println("terminated")
+	if len(dirs) == 0 {
+		return nil
+	}
 
-		if err != nil {
-			fmt.Printf("err = %+v\n", err)
-			return err
-		}
+	return m.syncBackContainer(container, dirs)
+}
+
+// syncBackContainer downloads changes made in the given directories of a container
+// until the container exits
+func (m *Manifest) syncBackContainer(container string, dirs []string) error {
+	dc, _ := docker.NewClientFromEnv()
+
+	exec, err := dc.CreateExec(docker.CreateExecOptions{
+		AttachStdin:  false,
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          append([]string{"/changes"}, dirs...),
+		Container:    container,
+	})
+
+	if err != nil {
+		fmt.Printf("err = %+v\n", err)
+		return err
+	}
+
+	r, w := io.Pipe()
+	done := make(chan bool)
+
+	defer close(done)
+
+	go m.scanRemoteChanges(container, r)
+	go m.processRemoteChanges(container, done)
+
+	err = dc.StartExec(exec.ID, docker.StartExecOptions{
+		Tty:          true,
+		RawTerminal:  true,
+		OutputStream: w,
+		ErrorStream:  w,
+	})
+
+	w.Close()
+
+	fmt.Println("terminated")
 
+	if err != nil {
+		fmt.Printf("err = %+v\n", err)
+		return err
 	}
 
 	return nil
@@ -1600,7 +2126,7 @@ func (m *Manifest) scanRemoteChanges(container string, r io.Reader) {
 	}
 }
 
-func (m *Manifest) processRemoteChanges(container string) {
+func (m *Manifest) processRemoteChanges(container string, done chan bool) {
 	for {
 		remoteAddLock.Lock()
 
@@ -1634,7 +2160,11 @@ func (m *Manifest) processRemoteChanges(container string) {
 
 		remoteRemoveLock.Unlock()
 
-		time.Sleep(1 * time.Second)
+		select {
+		case <-done:
+			return
+		case <-time.After(1 * time.Second):
+		}
 	}
 }
 
@@ -1763,8 +2293,6 @@ func (m *Manifest) syncFiles() error {
 }
 
 func (m *Manifest) processSync(local string, syncs []Sync) error {
-	files := Files{}
-
 	adds := map[string]bool{}
 	removes := map[string]bool{}
 
@@ -1773,57 +2301,45 @@ func (m *Manifest) processSync(local string, syncs []Sync) error {
 	go processAdds(m.systemPrefix(), adds, alock, syncs)
 	go processRemoves(m.systemPrefix(), removes, rlock, syncs)
 
-	filepath.Walk(local, func(path string, info os.FileInfo, err error) error {
-		if info != nil {
-			files[path] = info.ModTime()
-		}
-		return nil
-	})
-
-	for {
-		di, err := readDockerIgnore()
-		if err != nil {
-			return err
-		}
-
-		patterns, patDirs, exceptions, err := fileutils.CleanPatterns(di)
-		if err != nil {
-			return err
-		}
-
-		cwd, err := os.Getwd()
-		if err != nil {
-			return err
-		}
-
-		err = filepath.Walk(local, watchWalker(files, local, adds, alock, cwd, patterns, patDirs, exceptions))
-		for _, sync := range syncs {
-			if err != nil {
-				fmt.Printf("err: %+v\n", err)
-				continue
-			}
+	ch := make(chan []fswatch.Change)
+	errch := make(chan error, 1)
 
-			for file := range files {
-				if _, err := os.Stat(file); os.IsNotExist(err) {
-					rel, err := filepath.Rel(local, file)
+	go func() {
+		errch <- fswatch.Watch(local, fswatch.Options{IgnoreFile: ".dockerignore"}, ch)
+	}()
 
-					if err != nil {
-						continue
+	for {
+		select {
+		case changes := <-ch:
+			for _, c := range changes {
+				path := filepath.Join(c.Base, c.Path)
+
+				switch c.Operation {
+				case "add":
+					blockLocalAddsLock.Lock()
+
+					if blockLocalAdds[path] > 0 {
+						blockLocalAdds[path] -= 1
+					} else {
+						alock.Lock()
+						adds[path] = true
+						alock.Unlock()
 					}
 
-					rlock.Lock()
-					removes[filepath.Join(sync.Remote, rel)] = true
-					rlock.Unlock()
-
-					delete(files, file)
+					blockLocalAddsLock.Unlock()
+				case "delete":
+					for _, sync := range syncs {
+						rlock.Lock()
+						removes[filepath.Join(sync.Remote, c.Path)] = true
+						rlock.Unlock()
+					}
 				}
 			}
+		case err := <-errch:
+			fmt.Printf("err: %+v\n", err)
+			return err
 		}
-
-		time.Sleep(900 * time.Millisecond)
 	}
-
-	return nil
 }
 
 func warnIfRoot(volume string) {
@@ -1841,16 +2357,26 @@ func warnIfRoot(volume string) {
 
 func detectApplication(dir string) string {
 	switch {
-	// case exists(filepath.Join(dir, ".meteor")):
-	//   return "meteor"
-	// case exists(filepath.Join(dir, "package.json")):
-	//   return "node"
 	case exists(filepath.Join(dir, "config/application.rb")):
 		return "rails"
 	case exists(filepath.Join(dir, "config.ru")):
 		return "sinatra"
 	case exists(filepath.Join(dir, "Gemfile.lock")):
 		return "ruby"
+	case exists(filepath.Join(dir, ".meteor")):
+		return "meteor"
+	case exists(filepath.Join(dir, "package.json")):
+		return "node"
+	case exists(filepath.Join(dir, "requirements.txt")), exists(filepath.Join(dir, "Pipfile")):
+		return "python"
+	case exists(filepath.Join(dir, "go.mod")), exists(filepath.Join(dir, "Godeps")):
+		return "go"
+	case exists(filepath.Join(dir, "pom.xml")):
+		return "maven"
+	case exists(filepath.Join(dir, "build.gradle")), exists(filepath.Join(dir, "build.gradle.kts")):
+		return "gradle"
+	case exists(filepath.Join(dir, "index.html")):
+		return "static"
 	}
 
 	return "unknown"
@@ -1882,7 +2408,7 @@ func initApplication(dir string) error {
 		}
 	}
 
-	if err := generateManifest(dir, fmt.Sprintf("init/%s/docker-compose.yml", kind)); err != nil {
+	if err := generateManifest(dir, kind); err != nil {
 		return err
 	}
 
@@ -1893,9 +2419,25 @@ func initApplication(dir string) error {
 	return nil
 }
 
-func generateManifest(dir string, def string) error {
+func generateManifest(dir string, kind string) error {
+	def := fmt.Sprintf("init/%s/docker-compose.yml", kind)
+
+	pf := Procfile{}
+
 	if exists("Procfile") {
-		pf, err := readProcfile("Procfile")
+		p, err := readProcfile("Procfile")
+
+		if err != nil {
+			return err
+		}
+
+		pf = p
+	} else {
+		pf = conventionalProcfile(dir, kind)
+	}
+
+	if len(pf) > 0 {
+		ports, labels, err := webDefaults(def)
 
 		if err != nil {
 			return err
@@ -1905,21 +2447,14 @@ func generateManifest(dir string, def string) error {
 
 		for _, e := range pf {
 			me := ManifestEntry{
-				Build:   ".",
+				Build:   &Build{Context: "."},
 				Command: e.Command,
 			}
 
 			switch e.Name {
 			case "web":
-				me.Labels = []string{
-					"convox.port.443.protocol=tls",
-					"convox.port.443.proxy=true",
-				}
-
-				me.Ports = []string{
-					"80:4000",
-					"443:4001",
-				}
+				me.Labels = labels
+				me.Ports = ports
 			}
 
 			m[e.Name] = me
@@ -2010,20 +2545,3 @@ func writeAsset(path, template string) error {
 
 	return writeFile(path, data, info.Mode())
 }
-
-func readDockerIgnore() ([]string, error) {
-	fd, err := os.Open(".dockerignore")
-	if os.IsNotExist(err) {
-		return []string{}, nil
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	ignore, err := dockerignore.ReadAll(fd)
-	if err != nil {
-		return nil, err
-	}
-
-	return ignore, nil
-}
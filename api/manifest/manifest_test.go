@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/fatih/color"
@@ -93,6 +94,213 @@ postgres:
 	_assert(t, cases)
 }
 
+func TestSubset(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  links:
+    - api:backend
+api:
+  links:
+    - postgres
+worker:
+  links:
+    - redis
+search:
+  image: elasticsearch
+redis:
+  image: convox/redis
+postgres:
+  image: convox/postgres
+`)
+
+	_ = yaml.Unmarshal(data, &m)
+
+	web, err := m.Subset([]string{"web"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := m.Subset(nil, []string{"search"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, errExcluded := m.Subset([]string{"web", "worker"}, []string{"postgres"})
+	_, errUnknown := m.Subset([]string{"mail"}, nil)
+
+	cases := Cases{
+		{web.runOrder(), []string{"postgres", "api", "web"}},
+		{all.runOrder(), []string{"postgres", "redis", "worker", "api", "web"}},
+		{errExcluded.Error(), "process api depends on postgres which is excluded"},
+		{errUnknown.Error(), "no such process: mail"},
+	}
+
+	_assert(t, cases)
+}
+
+func TestBuildArgs(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  build: .
+worker:
+  build:
+    context: worker
+    dockerfile: Dockerfile.worker
+    args:
+      - RAILS_ENV=production
+      - NPM_TOKEN
+`)
+
+	err := yaml.Unmarshal(data, &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worker := m["worker"].Build
+
+	cases := Cases{
+		{m["web"].Build, &Build{Context: "."}},
+		{worker.Context, "worker"},
+		{worker.Dockerfile, "Dockerfile.worker"},
+		{worker.ResolvedArgs(map[string]string{"NPM_TOKEN": "secret"}), map[string]string{"NPM_TOKEN": "secret", "RAILS_ENV": "production"}},
+	}
+
+	_assert(t, cases)
+
+	raw, err := m.Raw()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases = Cases{
+		{string(raw), `web:
+  build: .
+worker:
+  build:
+    context: worker
+    dockerfile: Dockerfile.worker
+    args:
+      NPM_TOKEN: ""
+      RAILS_ENV: production
+`},
+	}
+
+	_assert(t, cases)
+}
+
+func TestBuildCacheFrom(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  build:
+    context: .
+    args:
+      - NPM_TOKEN
+`)
+
+	_ = yaml.Unmarshal(data, &m)
+
+	Execer = func(bin string, args ...string) *exec.Cmd {
+		// the shared cache image does not exist
+		if len(args) > 1 && args[0] == "pull" && args[1] == "convox/missing" {
+			return exec.Command("false")
+		}
+
+		if len(args) > 1 && args[0] == "build" && args[1] == "--help" {
+			return exec.Command("echo", "--cache-from")
+		}
+
+		return exec.Command("true")
+	}
+	defer func() { Execer = exec.Command }()
+
+	defer stubDocker(&fakeDocker{APIVersion: "1.25"})()
+
+	stdout, _ := testRunner(&m, "app", func() {
+		m.Build("app", ".", BuildOptions{
+			Cache:       true,
+			CacheFrom:   []string{"registry/app:web.BPREVIOUS", "convox/missing"},
+			Environment: map[string]string{"NPM_TOKEN": "secret"},
+		})
+	})
+
+	if !strings.Contains(stdout, "--cache-from registry/app:web.BPREVIOUS --build-arg NPM_TOKEN") {
+		t.Errorf("expected build with cache source, got: %s", stdout)
+	}
+
+	if strings.Contains(stdout, "--cache-from convox/missing") {
+		t.Errorf("expected missing cache image to be skipped, got: %s", stdout)
+	}
+
+	if strings.Contains(stdout, "secret") {
+		t.Errorf("expected build arg values to stay out of build output, got: %s", stdout)
+	}
+}
+
+func TestBuildCacheFromUnsupported(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  build: .
+`)
+
+	_ = yaml.Unmarshal(data, &m)
+
+	pulled := false
+
+	Execer = func(bin string, args ...string) *exec.Cmd {
+		if len(args) > 0 && args[0] == "pull" {
+			pulled = true
+		}
+
+		return exec.Command("true")
+	}
+	defer func() { Execer = exec.Command }()
+
+	// docker 1.12
+	defer stubDocker(&fakeDocker{APIVersion: "1.24"})()
+
+	stdout, _ := testRunner(&m, "app", func() {
+		m.Build("app", ".", BuildOptions{
+			Cache:     true,
+			CacheFrom: []string{"registry/app:web.BPREVIOUS"},
+		})
+	})
+
+	if strings.Contains(stdout, "--cache-from registry") {
+		t.Errorf("expected build without cache source, got: %s", stdout)
+	}
+
+	if pulled {
+		t.Errorf("expected cache image not to be pulled")
+	}
+
+	if !strings.Contains(stdout, "WARNING: docker does not support --cache-from") {
+		t.Errorf("expected warning, got: %s", stdout)
+	}
+}
+
+func TestImages(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  image: httpd
+`)
+
+	_ = yaml.Unmarshal(data, &m)
+
+	Execer = func(bin string, args ...string) *exec.Cmd {
+		return exec.Command("echo", `["registry/other@sha256:aaa","registry/repo@sha256:bbb"] 1024`)
+	}
+	defer func() { Execer = exec.Command }()
+
+	images, err := m.Images("app", "registry", "BABC", "repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if images["web"] != (Image{Digest: "sha256:bbb", Size: 1024}) {
+		t.Errorf("expected digest of pushed repository, got: %+v", images)
+	}
+}
+
 type TestCommand struct {
 	Command string
 	Args    []string
@@ -262,11 +470,11 @@ func _assert(t *testing.T, cases Cases) {
 type runnerFn func()
 
 func testBuild(m *Manifest, app string) (string, string) {
-	return testRunner(m, app, func() { m.Build(app, ".", true) })
+	return testRunner(m, app, func() { m.Build(app, ".", BuildOptions{Cache: true}) })
 }
 
 func testRun(m *Manifest, app string) (string, string) {
-	return testRunner(m, app, func() { m.Run(app, true, true, 0) })
+	return testRunner(m, app, func() { m.Run(app, RunOptions{Cache: true, Sync: true}) })
 }
 
 func testRunner(m *Manifest, app string, fn runnerFn) (string, string) {
@@ -0,0 +1,343 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ShiftStep is the distance between the shifts AutoShift tries
+const ShiftStep = 1000
+
+// PortState is the host ports convox start allocated for an app. It is saved in the
+// .convox/ports.json file of the app directory so the ports of an app stay the same
+// across restarts.
+type PortState struct {
+	// Shift is added to the host port of every port declaration
+	Shift int `json:"shift"`
+
+	// Proxies are the host ports published by the containers behind https and tls
+	// proxies, by process and the host port of the proxy
+	Proxies map[string]map[string]int `json:"proxies,omitempty"`
+
+	// Published are the host ports of ports a process publishes on a free host port, by
+	// process and container port
+	Published map[string]map[string]int `json:"published,omitempty"`
+
+	file string
+	app  string
+}
+
+// HostURL is the address on the docker host of a port a process publishes
+type HostURL struct {
+	Process   string
+	Container string
+	URL       string
+}
+
+// portMapping is a host port and the container port it publishes. Declared is the host
+// port before the shift, which convox.port.<port> labels refer to.
+type portMapping struct {
+	Host      string
+	Container string
+	Declared  string
+}
+
+// LoadPortState reads the port state of an app from the .convox/ports.json file in dir.
+// An app without saved state gets an empty state.
+func LoadPortState(dir, app string) (*PortState, error) {
+	file := filepath.Join(dir, ".convox", "ports.json")
+
+	apps, err := readPortStates(file)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, ok := apps[app]
+	if !ok {
+		ps = &PortState{}
+	}
+
+	if ps.Proxies == nil {
+		ps.Proxies = map[string]map[string]int{}
+	}
+
+	if ps.Published == nil {
+		ps.Published = map[string]map[string]int{}
+	}
+
+	ps.file = file
+	ps.app = app
+
+	return ps, nil
+}
+
+// Save writes the port state of the app back to the state file, keeping the state of
+// other apps that share the directory
+func (ps *PortState) Save() error {
+	if ps.file == "" {
+		return fmt.Errorf("port state was not loaded from a file")
+	}
+
+	apps, err := readPortStates(ps.file)
+	if err != nil {
+		return err
+	}
+
+	apps[ps.app] = ps
+
+	data, err := json.MarshalIndent(apps, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(ps.file), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(ps.file, append(data, '\n'), 0644)
+}
+
+// Allocate picks the host ports for the proxied ports and the ports published on a free
+// host port of the manifest that do not have one yet, avoiding the ports of the manifest
+// and the ports already allocated
+func (ps *PortState) Allocate(m *Manifest) error {
+	used := map[int]bool{}
+
+	for _, p := range m.PortsWanted(ps.Shift) {
+		if pi, err := strconv.Atoi(p); err == nil {
+			used[pi] = true
+		}
+	}
+
+	for _, allocated := range []map[string]map[string]int{ps.Proxies, ps.Published} {
+		for _, ports := range allocated {
+			for _, p := range ports {
+				used[p] = true
+			}
+		}
+	}
+
+	allocate := func(allocated map[string]map[string]int, name, key string) {
+		if allocated[name] == nil {
+			allocated[name] = map[string]int{}
+		}
+
+		if _, ok := allocated[name][key]; ok {
+			return
+		}
+
+		port := RandomPort()
+
+		for used[port] {
+			port = RandomPort()
+		}
+
+		used[port] = true
+		allocated[name][key] = port
+	}
+
+	for _, name := range m.names() {
+		me := (*m)[name]
+
+		mappings, err := me.portMappings(ps.Shift)
+		if err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+
+		for _, pm := range mappings {
+			switch {
+			case pm.Host == "":
+				allocate(ps.Published, name, pm.Container)
+			case me.proxied(pm.Declared):
+				allocate(ps.Proxies, name, pm.Host)
+			}
+		}
+	}
+
+	return nil
+}
+
+// proxyPort returns the allocated host port for the container behind the proxy on a
+// host port, or a random one when the state has none
+func (ps *PortState) proxyPort(process, host string) int {
+	if ps != nil {
+		if port, ok := ps.Proxies[process][host]; ok {
+			return port
+		}
+	}
+
+	return RandomPort()
+}
+
+// publishedPort returns the allocated host port for a container port published on a free
+// host port, or 0 when the state has none and docker should pick one
+func (ps *PortState) publishedPort(process, container string) int {
+	if ps != nil {
+		return ps.Published[process][container]
+	}
+
+	return 0
+}
+
+// AutoShift returns the first shift, starting at the given one and going up in steps of
+// ShiftStep, that leaves none of the ports of the manifest in use
+func (m *Manifest) AutoShift(start int) (int, error) {
+	for shift := start; shift < start+50*ShiftStep; shift += ShiftStep {
+		conflicts, err := m.PortConflicts(shift)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(conflicts) == 0 {
+			return shift, nil
+		}
+	}
+
+	return 0, fmt.Errorf("could not find a shift that leaves the ports of the manifest free")
+}
+
+// HostURLs returns the addresses of the ports the processes publish, sorted by process
+// and in the order of the port declarations. The scheme is the LINK_SCHEME of the
+// process, the protocol of a proxied port, or http.
+func (m *Manifest) HostURLs(ps *PortState) ([]HostURL, error) {
+	urls := []HostURL{}
+	host := dockerHost()
+
+	for _, name := range m.names() {
+		me := (*m)[name]
+
+		mappings, err := me.portMappings(ps.Shift)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err)
+		}
+
+		scheme := "http"
+
+		for _, e := range me.EnvironmentArray() {
+			if strings.HasPrefix(e, "LINK_SCHEME=") {
+				scheme = strings.TrimPrefix(e, "LINK_SCHEME=")
+			}
+		}
+
+		for _, pm := range mappings {
+			if pm.Host == "" {
+				p := ps.publishedPort(name, pm.Container)
+
+				// docker picks the host port when the container runs
+				if p == 0 {
+					continue
+				}
+
+				pm.Host = strconv.Itoa(p)
+			}
+
+			s := scheme
+
+			if me.proxied(pm.Declared) {
+				s = me.Label(fmt.Sprintf("convox.port.%s.protocol", pm.Declared))
+			}
+
+			urls = append(urls, HostURL{
+				Process:   name,
+				Container: pm.Container,
+				URL:       fmt.Sprintf("%s://%s:%s", s, host, pm.Host),
+			})
+		}
+	}
+
+	return urls, nil
+}
+
+// portMappings returns the host and container ports of the port declarations of an
+// entry with the host ports shifted. A convox.start.shift label overrides the shift. On an
+// entry labelled convox.start.publish=random a port declared alone gets no host port, it
+// is published on the port allocated in the port state or on one docker picks.
+func (me ManifestEntry) portMappings(shift int) ([]portMapping, error) {
+	ports := []string{}
+
+	switch t := me.Ports.(type) {
+	case []string:
+		ports = append(ports, t...)
+	case []interface{}:
+		for _, port := range t {
+			ports = append(ports, fmt.Sprintf("%v", port))
+		}
+	}
+
+	if s := me.Label("convox.start.shift"); s != "" {
+		si, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+
+		shift = si
+	}
+
+	mappings := []portMapping{}
+
+	for _, port := range ports {
+		pm := portMapping{}
+
+		switch parts := strings.Split(port, ":"); len(parts) {
+		case 1:
+			// docker picks a free host port
+			if me.Label("convox.start.publish") == "random" {
+				mappings = append(mappings, portMapping{Container: port})
+				continue
+			}
+
+			pm.Host = port
+			pm.Container = port
+		case 2:
+			pm.Host = parts[0]
+			pm.Container = parts[1]
+		default:
+			return nil, fmt.Errorf("unknown port declaration: %s", port)
+		}
+
+		hosti, err := strconv.Atoi(pm.Host)
+		if err != nil {
+			return nil, err
+		}
+
+		pm.Declared = pm.Host
+		pm.Host = strconv.Itoa(hosti + shift)
+
+		mappings = append(mappings, pm)
+	}
+
+	return mappings, nil
+}
+
+// proxied returns true when convox start puts an https or tls proxy on a host port
+func (me ManifestEntry) proxied(host string) bool {
+	switch me.Label(fmt.Sprintf("convox.port.%s.protocol", host)) {
+	case "https", "tls":
+		return true
+	}
+
+	return false
+}
+
+func readPortStates(file string) (map[string]*PortState, error) {
+	apps := map[string]*PortState{}
+
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return apps, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &apps); err != nil {
+		return nil, fmt.Errorf("could not read %s: %s", file, err)
+	}
+
+	return apps, nil
+}
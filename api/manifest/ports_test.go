@@ -0,0 +1,173 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+)
+
+func TestPortStateSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ports")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m := Manifest{
+		"web": ManifestEntry{
+			Ports:  []interface{}{"80:3000", "443:3000"},
+			Labels: []interface{}{"convox.port.443.protocol=tls"},
+		},
+	}
+
+	random := RandomPort
+	defer func() { RandomPort = random }()
+
+	RandomPort = func() int { return 20000 }
+
+	ps, err := LoadPortState(dir, "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ps.Allocate(&m); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ps.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := LoadPortState(dir, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other.Shift = 1000
+
+	if err := other.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a restart keeps the allocated port even if another one would be picked
+	RandomPort = func() int { return 30000 }
+
+	restarted, err := LoadPortState(dir, "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := restarted.Allocate(&m); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err = LoadPortState(dir, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{restarted.Proxies, map[string]map[string]int{"web": {"443": 20000}}},
+		{restarted.proxyPort("web", "443"), 20000},
+		{other.Shift, 1000},
+	}
+
+	_assert(t, cases)
+}
+
+func TestHostURLs(t *testing.T) {
+	m := Manifest{
+		"database": LocalServices["postgres"].entry(),
+		"web": ManifestEntry{
+			Ports:  []interface{}{"80:3000", "443:3000"},
+			Labels: []interface{}{"convox.port.443.protocol=tls"},
+		},
+		"worker": ManifestEntry{
+			Ports:  []interface{}{"5000"},
+			Labels: []interface{}{"convox.start.shift=10"},
+		},
+	}
+
+	ps := &PortState{Published: map[string]map[string]int{"database": {"5432": 25432}}}
+
+	urls, err := m.HostURLs(ps)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{urls, []HostURL{
+			{Process: "database", Container: "5432", URL: "postgres://127.0.0.1:25432"},
+			{Process: "web", Container: "3000", URL: "http://127.0.0.1:80"},
+			{Process: "web", Container: "3000", URL: "tls://127.0.0.1:443"},
+			{Process: "worker", Container: "5000", URL: "http://127.0.0.1:5010"},
+		}},
+	}
+
+	_assert(t, cases)
+}
+
+func TestShiftedProxyPorts(t *testing.T) {
+	m := Manifest{
+		"web": ManifestEntry{
+			Ports:  []interface{}{"80:3000", "443:3000"},
+			Labels: []interface{}{"convox.port.443.protocol=tls"},
+		},
+	}
+
+	random := RandomPort
+	defer func() { RandomPort = random }()
+
+	RandomPort = func() int { return 20000 }
+
+	// labels refer to the declared port, not the shifted one
+	ps := &PortState{Shift: 1000, Proxies: map[string]map[string]int{}, Published: map[string]map[string]int{}}
+
+	if err := ps.Allocate(&m); err != nil {
+		t.Fatal(err)
+	}
+
+	urls, err := m.HostURLs(ps)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{ps.Proxies, map[string]map[string]int{"web": {"1443": 20000}}},
+		{urls, []HostURL{
+			{Process: "web", Container: "3000", URL: "http://127.0.0.1:1080"},
+			{Process: "web", Container: "3000", URL: "tls://127.0.0.1:1443"},
+		}},
+	}
+
+	_assert(t, cases)
+}
+
+func TestAutoShift(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	port := l.Addr().(*net.TCPAddr).Port
+
+	m := Manifest{
+		"web": ManifestEntry{
+			Ports: []interface{}{fmt.Sprintf("%d:3000", port-ShiftStep)},
+		},
+	}
+
+	shift, err := m.AutoShift(ShiftStep)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{shift, 2 * ShiftStep},
+	}
+
+	_assert(t, cases)
+}
@@ -0,0 +1,153 @@
+package manifest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LocalService is a container that stands in for a rack service during convox start.
+// Its LINK_ variables give the processes that use it the same <NAME>_URL variables as
+// a link to the rack service would. Its port is published on a free host port so it
+// does not collide with databases running on the host.
+type LocalService struct {
+	Image       string
+	Entrypoint  string
+	Command     []string
+	Environment []string
+	Port        string
+}
+
+// LocalServices are the rack service types that can run locally, keyed by the type in
+// convox.service.<type> labels
+var LocalServices = map[string]LocalService{
+	"elasticsearch": {
+		Image:       "elasticsearch:5.5-alpine",
+		Environment: []string{"ES_JAVA_OPTS=-Xms256m -Xmx256m", "LINK_SCHEME=http"},
+		Port:        "9200",
+	},
+	"memcached": {
+		Image:       "memcached:1.4-alpine",
+		Environment: []string{"LINK_SCHEME=memcached"},
+		Port:        "11211",
+	},
+	"mysql": {
+		Image:       "mysql:5.7",
+		Environment: []string{"MYSQL_DATABASE=app", "MYSQL_ROOT_PASSWORD=password", "LINK_SCHEME=mysql", "LINK_USERNAME=root", "LINK_PASSWORD=password", "LINK_PATH=/app"},
+		Port:        "3306",
+	},
+	"postgres": {
+		Image:       "postgres:9.6",
+		Environment: []string{"POSTGRES_DB=app", "POSTGRES_USER=postgres", "POSTGRES_PASSWORD=password", "LINK_SCHEME=postgres", "LINK_USERNAME=postgres", "LINK_PASSWORD=password", "LINK_PATH=/app"},
+		Port:        "5432",
+	},
+	"rabbitmq": {
+		Image:       "rabbitmq:3.8-alpine",
+		Environment: []string{"RABBITMQ_DEFAULT_USER=app", "RABBITMQ_DEFAULT_PASS=password", "LINK_SCHEME=amqp", "LINK_USERNAME=app", "LINK_PASSWORD=password"},
+		Port:        "5672",
+	},
+	"redis": {
+		Image:       "redis:3.2",
+		Environment: []string{"LINK_SCHEME=redis", "LINK_PATH=/0"},
+		Port:        "6379",
+	},
+	"s3": {
+		Image:       "minio/minio:RELEASE.2019-10-12T01-39-57Z",
+		Entrypoint:  "sh",
+		Command:     []string{"-c", "mkdir -p /data/app && minio server /data"},
+		Environment: []string{"MINIO_ACCESS_KEY=access", "MINIO_SECRET_KEY=password", "LINK_SCHEME=s3", "LINK_USERNAME=access", "LINK_PASSWORD=password", "LINK_PATH=/app"},
+		Port:        "9000",
+	},
+	"sqs": {
+		Image:       "roribio16/alpine-sqs:1.2.0",
+		Environment: []string{"LINK_SCHEME=sqs", "LINK_USERNAME=access", "LINK_PASSWORD=password", "LINK_PATH=/queue/default"},
+		Port:        "9324",
+	},
+}
+
+// LocalServiceTypes returns the sorted types of the services that can run locally
+func LocalServiceTypes() []string {
+	types := []string{}
+
+	for t := range LocalServices {
+		types = append(types, t)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+// AddLocalServices adds an entry for every service declared with a
+// convox.service.<type>=<name> label and links the labelled entries to it. The name
+// defaults to the type and determines the link variables, e.g. DATABASE_URL for
+// convox.service.postgres=database.
+func (m Manifest) AddLocalServices() error {
+	added := map[string]string{}
+
+	for _, name := range m.names() {
+		entry := m[name]
+		labels := entry.labelsByPrefix("convox.service.")
+
+		for _, key := range sortedKeys(labels) {
+			kind := strings.TrimPrefix(key, "convox.service.")
+
+			service, ok := LocalServices[kind]
+			if !ok {
+				return fmt.Errorf("%s: unknown service type %s, must be one of: %s", name, kind, strings.Join(LocalServiceTypes(), ", "))
+			}
+
+			sname := labels[key]
+
+			if sname == "" || sname == "true" {
+				sname = kind
+			}
+
+			if !regexValidProcessName.MatchString(sname) {
+				return fmt.Errorf("%s: service name %q is invalid. It should contain only alphanumeric characters and dashes.", name, sname)
+			}
+
+			switch existing, ok := added[sname]; {
+			case ok && existing != kind:
+				return fmt.Errorf("%s: service %s is already a %s service", name, sname, existing)
+			case !ok:
+				if _, exists := m[sname]; exists {
+					return fmt.Errorf("%s: service %s conflicts with the process of the same name", name, sname)
+				}
+
+				m[sname] = service.entry()
+				added[sname] = kind
+			}
+
+			if !contains(entry.Links, sname) {
+				entry.Links = append(entry.Links, sname)
+			}
+		}
+
+		m[name] = entry
+	}
+
+	return nil
+}
+
+func (ls LocalService) entry() ManifestEntry {
+	env := []interface{}{}
+
+	for _, e := range ls.Environment {
+		env = append(env, e)
+	}
+
+	me := ManifestEntry{
+		Image:       ls.Image,
+		Entrypoint:  ls.Entrypoint,
+		Environment: env,
+		Labels:      []interface{}{"convox.start.publish=random"},
+		Ports:       []interface{}{ls.Port},
+	}
+
+	if len(ls.Command) > 0 {
+		me.Command = ls.Command
+	}
+
+	return me
+}
@@ -0,0 +1,241 @@
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WatchInterval is how often files that affect an image are checked for changes
+var WatchInterval = 1 * time.Second
+
+// WatchDebounce is how long files must stay unchanged before a rebuild starts
+var WatchDebounce = 2 * time.Second
+
+// RebuildFiles are dependency lockfiles that cause a rebuild when found in a build context
+var RebuildFiles = []string{
+	"Cargo.lock",
+	"Gemfile.lock",
+	"Godeps/Godeps.json",
+	"Pipfile.lock",
+	"composer.lock",
+	"glide.lock",
+	"mix.lock",
+	"npm-shrinkwrap.json",
+	"package.json",
+	"requirements.txt",
+	"yarn.lock",
+}
+
+// RebuildPaths returns the paths, relative to the manifest, whose changes require the image
+// of an entry to be rebuilt: its Dockerfile, lockfiles and the comma separated paths or
+// patterns in its convox.start.rebuild label, which are relative to the build context
+func (me ManifestEntry) RebuildPaths() []string {
+	if me.Build == nil || me.Build.Context == "" {
+		return nil
+	}
+
+	df := "Dockerfile"
+	if me.Dockerfile != "" {
+		df = me.Dockerfile
+	}
+	if me.Build.Dockerfile != "" {
+		df = me.Build.Dockerfile
+	}
+
+	paths := []string{filepath.Join(me.Build.Context, df)}
+
+	for _, f := range RebuildFiles {
+		paths = append(paths, filepath.Join(me.Build.Context, f))
+	}
+
+	for _, p := range strings.Split(me.Label("convox.start.rebuild"), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, filepath.Join(me.Build.Context, p))
+		}
+	}
+
+	return paths
+}
+
+// rebuildSnapshot returns the modification times of the files matching paths in dir,
+// descending into matched directories
+func rebuildSnapshot(dir string, paths []string) map[string]time.Time {
+	snapshot := map[string]time.Time{}
+
+	for _, p := range paths {
+		matches, err := filepath.Glob(filepath.Join(dir, p))
+		if err != nil {
+			continue
+		}
+
+		for _, match := range matches {
+			filepath.Walk(match, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return nil
+				}
+
+				if !info.IsDir() {
+					snapshot[path] = info.ModTime()
+				}
+
+				return nil
+			})
+		}
+	}
+
+	return snapshot
+}
+
+// changedFiles returns the sorted files added, removed or modified between two snapshots
+func changedFiles(prev, cur map[string]time.Time) []string {
+	changed := []string{}
+
+	for path, mt := range cur {
+		if pmt, ok := prev[path]; !ok || !pmt.Equal(mt) {
+			changed = append(changed, path)
+		}
+	}
+
+	for path := range prev {
+		if _, ok := cur[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+// restartOrder returns the given entries and the entries linking to them, directly or
+// through other links, in start order. A restarted container gets a new address, so the
+// containers linking to it are restarted to pick up the new host entry.
+func (m *Manifest) restartOrder(names []string) []string {
+	restart := map[string]bool{}
+
+	for _, name := range names {
+		restart[name] = true
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for name, entry := range *m {
+			if restart[name] {
+				continue
+			}
+
+			for _, link := range entry.Links {
+				if restart[strings.Split(link, ":")[0]] {
+					restart[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	order := []string{}
+
+	for _, name := range m.runOrder() {
+		if restart[name] {
+			order = append(order, name)
+		}
+	}
+
+	return order
+}
+
+// watch rebuilds the images of entries whose rebuild paths change and sends the names of
+// rebuilt entries on restarts. A failed rebuild leaves the running container alone.
+func (m *Manifest) watch(app string, opts RunOptions, prefixes map[string]string, restarts chan []string, done chan bool) {
+	paths := map[string][]string{}
+	snapshots := map[string]map[string]time.Time{}
+
+	for name, entry := range *m {
+		if p := entry.RebuildPaths(); len(p) > 0 {
+			paths[name] = p
+			snapshots[name] = rebuildSnapshot(opts.Dir, p)
+		}
+	}
+
+	if len(paths) == 0 {
+		return
+	}
+
+	pending := map[string]bool{}
+	settled := time.Now()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(WatchInterval):
+		}
+
+		for name, p := range paths {
+			cur := rebuildSnapshot(opts.Dir, p)
+			changed := changedFiles(snapshots[name], cur)
+			snapshots[name] = cur
+
+			if len(changed) == 0 {
+				continue
+			}
+
+			for i, c := range changed {
+				if rel, err := filepath.Rel(opts.Dir, c); err == nil {
+					changed[i] = rel
+				}
+			}
+
+			fmt.Printf("%s %s changed, waiting for changes to settle\n", prefixes[name], strings.Join(changed, ", "))
+
+			pending[name] = true
+			settled = time.Now().Add(WatchDebounce)
+		}
+
+		if len(pending) == 0 || time.Now().Before(settled) {
+			continue
+		}
+
+		names := []string{}
+
+		for name := range pending {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		pending = map[string]bool{}
+		rebuilt := []string{}
+
+		for _, name := range names {
+			fmt.Printf("%s rebuilding image\n", prefixes[name])
+
+			sub := Manifest{name: (*m)[name]}
+
+			if errs := sub.Build(app, opts.Dir, BuildOptions{Cache: true, Environment: opts.Environment}); len(errs) > 0 {
+				fmt.Printf("%s rebuild failed, keeping the running container: %s\n", prefixes[name], errs[0])
+				continue
+			}
+
+			fmt.Printf("%s restarting with the new image\n", prefixes[name])
+
+			rebuilt = append(rebuilt, name)
+		}
+
+		if len(rebuilt) == 0 {
+			continue
+		}
+
+		select {
+		case restarts <- rebuilt:
+		case <-done:
+			return
+		}
+	}
+}
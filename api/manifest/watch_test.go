@@ -0,0 +1,118 @@
+package manifest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestRebuildPaths(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  build: .
+  labels:
+    - convox.start.rebuild=config/*.yml, vendor
+worker:
+  build:
+    context: worker
+    dockerfile: Dockerfile.worker
+redis:
+  image: convox/redis
+`)
+
+	err := yaml.Unmarshal(data, &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	web := m["web"].RebuildPaths()
+	worker := m["worker"].RebuildPaths()
+
+	cases := Cases{
+		{web[0], "Dockerfile"},
+		{web[len(web)-2:], []string{"config/*.yml", "vendor"}},
+		{worker[0], "worker/Dockerfile.worker"},
+		{worker[1], "worker/Cargo.lock"},
+		{len(worker), len(RebuildFiles) + 1},
+		{len(m["redis"].RebuildPaths()), 0},
+	}
+
+	_assert(t, cases)
+}
+
+func TestRestartOrder(t *testing.T) {
+	var m Manifest
+	data := []byte(`web:
+  build: .
+  links:
+    - api:backend
+api:
+  build: api
+  links:
+    - postgres
+worker:
+  build: .
+  links:
+    - redis
+postgres:
+  image: convox/postgres
+redis:
+  image: convox/redis
+`)
+
+	err := yaml.Unmarshal(data, &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := Cases{
+		{m.restartOrder([]string{"postgres"}), []string{"postgres", "api", "web"}},
+		{m.restartOrder([]string{"web", "redis"}), []string{"redis", "worker", "web"}},
+		{m.restartOrder([]string{"worker"}), []string{"worker"}},
+	}
+
+	_assert(t, cases)
+}
+
+func TestRebuildSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "convox-watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.MkdirAll(filepath.Join(dir, "vendor", "lib"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM scratch"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "vendor", "lib", "a.rb"), []byte("a"), 0644)
+
+	paths := []string{"Dockerfile", "Gemfile.lock", "vendor"}
+
+	prev := rebuildSnapshot(dir, paths)
+
+	cases := Cases{
+		{len(prev), 2},
+		{changedFiles(prev, rebuildSnapshot(dir, paths)), []string{}},
+	}
+
+	_assert(t, cases)
+
+	later := time.Now().Add(1 * time.Minute)
+
+	ioutil.WriteFile(filepath.Join(dir, "Gemfile.lock"), []byte("GEM"), 0644)
+	os.Chtimes(filepath.Join(dir, "Dockerfile"), later, later)
+	os.Remove(filepath.Join(dir, "vendor", "lib", "a.rb"))
+
+	cases = Cases{
+		{changedFiles(prev, rebuildSnapshot(dir, paths)), []string{
+			filepath.Join(dir, "Dockerfile"),
+			filepath.Join(dir, "Gemfile.lock"),
+			filepath.Join(dir, "vendor", "lib", "a.rb"),
+		}},
+	}
+
+	_assert(t, cases)
+}
@@ -222,6 +222,11 @@ func (a *App) Cleanup() error {
 		provider.BuildDelete(a.Name, build.Id)
 	}
 
+	// builds past the listed ones also recorded the index blobs they used
+	if err := provider.IndexForget(a.Name); err != nil {
+		return err
+	}
+
 	// FIXME: ReleaseList only lists and cleans up the last 20 builds/releases
 	// FIXME: Should the delete calls happen in a goroutine?
 	releases, err := provider.ReleaseList(a.Name)
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/docker/go-units"
 	"gopkg.in/yaml.v2"
 )
 
@@ -21,7 +22,7 @@ type Manifest []ManifestEntry
 type ManifestEntry struct {
 	Name string
 
-	Build      string                   `yaml:"build"`
+	Build      interface{}              `yaml:"build"`
 	Command    interface{}              `yaml:"command"`
 	Env        MapOrEqualSlice          `yaml:"environment"`
 	Exports    map[string]string        `yaml:"-"`
@@ -29,9 +30,12 @@ type ManifestEntry struct {
 	Labels     interface{}              `yaml:"labels"`
 	Links      []string                 `yaml:"links"`
 	LinkVars   map[string]template.HTML `yaml:"-"`
+	MemLimit   interface{}              `yaml:"mem_limit"`
 	Ports      []string                 `yaml:"ports"`
 	Privileged bool                     `yaml:"privileged"`
+	User       string                   `yaml:"user"`
 	Volumes    []string                 `yaml:"volumes"`
+	WorkingDir string                   `yaml:"working_dir"`
 
 	app     *App
 	primary bool
@@ -468,6 +472,24 @@ func (me ManifestEntry) EnvMap() map[string]string {
 	return envs
 }
 
+// MemoryDefault returns the MB of RAM to reserve for a new process, from its mem_limit
+func (me ManifestEntry) MemoryDefault() int64 {
+	var bytes int64
+
+	switch t := me.MemLimit.(type) {
+	case int:
+		bytes = int64(t)
+	case string:
+		bytes, _ = units.RAMInBytes(t)
+	}
+
+	if mb := bytes / (1024 * 1024); mb > 0 {
+		return mb
+	}
+
+	return 256
+}
+
 func (me ManifestEntry) MountableVolumes() []string {
 	volumes := []string{}
 
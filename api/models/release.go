@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/convox/rack/api/crypt"
+	"github.com/convox/rack/api/provider"
 )
 
 type Release struct {
@@ -28,6 +29,13 @@ type Release struct {
 
 type Releases []Release
 
+// ReleaseRender is the template and parameters a release would apply to its app
+type ReleaseRender struct {
+	Template   string            `json:"template"`
+	Parameters map[string]string `json:"parameters"`
+	Current    string            `json:"current"`
+}
+
 func NewRelease(app string) Release {
 	return Release{
 		Id:  generateId("R", 10),
@@ -154,6 +162,73 @@ func (r *Release) Promote() error {
 		return err
 	}
 
+	manifest, err := LoadManifest(r.Manifest, app)
+
+	if err != nil {
+		return err
+	}
+
+	for _, certParam := range r.applyParameters(app, manifest) {
+		name := fmt.Sprintf("cert-%d", time.Now().Unix())
+
+		body, key, err := GenerateSelfSignedCertificate("*.*.elb.amazonaws.com")
+
+		if err != nil {
+			return err
+		}
+
+		input := &iam.UploadServerCertificateInput{
+			CertificateBody:       aws.String(string(body)),
+			PrivateKey:            aws.String(string(key)),
+			ServerCertificateName: aws.String(name),
+		}
+
+		// upload certificate
+		res, err := IAM().UploadServerCertificate(input)
+
+		if err != nil {
+			return err
+		}
+
+		app.Parameters[certParam] = *res.ServerCertificateMetadata.Arn
+	}
+
+	params := []*cloudformation.Parameter{}
+
+	for key, value := range app.Parameters {
+		if _, ok := existing[key]; ok {
+			params = append(params, &cloudformation.Parameter{ParameterKey: aws.String(key), ParameterValue: aws.String(value)})
+		}
+	}
+
+	err = S3Put(app.Outputs["Settings"], fmt.Sprintf("templates/%s", r.Id), []byte(formation), false)
+
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://s3.amazonaws.com/%s/templates/%s", app.Outputs["Settings"], r.Id)
+
+	req := &cloudformation.UpdateStackInput{
+		Capabilities: []*string{aws.String("CAPABILITY_IAM")},
+		StackName:    aws.String(app.StackName()),
+		TemplateURL:  aws.String(url),
+		Parameters:   params,
+	}
+
+	_, err = UpdateStack(req)
+
+	NotifySuccess("release:promote", map[string]string{
+		"app": r.App,
+		"id":  r.Id,
+	})
+
+	return err
+}
+
+// applyParameters sets the app parameters that promoting the release changes and returns
+// the certificate parameters of secure ports that still need a certificate
+func (r *Release) applyParameters(app *App, manifest Manifest) []string {
 	app.Parameters["Environment"] = r.EnvironmentUrl()
 	app.Parameters["Kernel"] = CustomTopic
 	app.Parameters["Release"] = r.Id
@@ -172,11 +247,7 @@ func (r *Release) Promote() error {
 
 	app.Parameters["SubnetsPrivate"] = subnetsPrivate
 
-	manifest, err := LoadManifest(r.Manifest, app)
-
-	if err != nil {
-		return err
-	}
+	certs := []string{}
 
 	for _, entry := range manifest {
 		for _, mapping := range entry.PortMappings() {
@@ -213,64 +284,76 @@ func (r *Release) Promote() error {
 			switch app.Parameters[protoParam] {
 			case "https", "tls":
 				if app.Parameters[certParam] == "" {
-					name := fmt.Sprintf("cert-%d", time.Now().Unix())
+					certs = append(certs, certParam)
+				}
+			}
+		}
+	}
+
+	return certs
+}
+
+// Render returns the template and parameters that promoting the release would apply to
+// its app along with the template of the app as currently deployed
+func (r *Release) Render() (*ReleaseRender, error) {
+	app, err := GetApp(r.App)
+
+	if err != nil {
+		return nil, err
+	}
 
-					body, key, err := GenerateSelfSignedCertificate("*.*.elb.amazonaws.com")
+	formation, err := r.Formation()
 
-					if err != nil {
-						return err
-					}
+	if err != nil {
+		return nil, err
+	}
 
-					input := &iam.UploadServerCertificateInput{
-						CertificateBody:       aws.String(string(body)),
-						PrivateKey:            aws.String(string(key)),
-						ServerCertificateName: aws.String(name),
-					}
+	existing, err := formationParameters(formation)
 
-					// upload certificate
-					res, err := IAM().UploadServerCertificate(input)
+	if err != nil {
+		return nil, err
+	}
 
-					if err != nil {
-						return err
-					}
+	manifest, err := LoadManifest(r.Manifest, app)
 
-					app.Parameters[certParam] = *res.ServerCertificateMetadata.Arn
-				}
-			}
-		}
+	if err != nil {
+		return nil, err
 	}
 
-	params := []*cloudformation.Parameter{}
+	// certificates are only generated when the release is promoted
+	for _, certParam := range r.applyParameters(app, manifest) {
+		app.Parameters[certParam] = "(generated on promote)"
+	}
+
+	params := map[string]string{}
 
 	for key, value := range app.Parameters {
 		if _, ok := existing[key]; ok {
-			params = append(params, &cloudformation.Parameter{ParameterKey: aws.String(key), ParameterValue: aws.String(value)})
+			params[key] = value
 		}
 	}
 
-	err = S3Put(app.Outputs["Settings"], fmt.Sprintf("templates/%s", r.Id), []byte(formation), false)
+	res, err := CloudFormation().GetTemplate(&cloudformation.GetTemplateInput{
+		StackName: aws.String(app.StackName()),
+	})
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	url := fmt.Sprintf("https://s3.amazonaws.com/%s/templates/%s", app.Outputs["Settings"], r.Id)
+	current, err := prettyJson(*res.TemplateBody)
 
-	req := &cloudformation.UpdateStackInput{
-		Capabilities: []*string{aws.String("CAPABILITY_IAM")},
-		StackName:    aws.String(app.StackName()),
-		TemplateURL:  aws.String(url),
-		Parameters:   params,
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = UpdateStack(req)
-
-	NotifySuccess("release:promote", map[string]string{
-		"app": r.App,
-		"id":  r.Id,
-	})
+	render := &ReleaseRender{
+		Template:   formation,
+		Parameters: params,
+		Current:    current,
+	}
 
-	return err
+	return render, nil
 }
 
 func (r *Release) EnvironmentUrl() string {
@@ -339,9 +422,58 @@ func (r *Release) Formation() (string, error) {
 		return "", err
 	}
 
+	err = r.resolveServiceLinks(manifest)
+
+	if err != nil {
+		return "", err
+	}
+
 	return manifest.Formation()
 }
 
+// resolveServiceLinks sets the URL of services linked to a single process in the
+// environment of that process, links to the whole app are in the app environment.
+// Links to services that no longer exist are skipped.
+func (r *Release) resolveServiceLinks(manifest Manifest) error {
+	links, err := provider.LinkList(r.App)
+
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if link.Process == "" {
+			continue
+		}
+
+		s, err := provider.ServiceGet(link.Service)
+
+		if awsError(err) == "ValidationError" {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if s.Status == "deleting" {
+			continue
+		}
+
+		for i, entry := range manifest {
+			if entry.Name == link.Process {
+				if manifest[i].LinkVars == nil {
+					manifest[i].LinkVars = make(map[string]template.HTML)
+				}
+
+				manifest[i].LinkVars[link.Variable] = template.HTML(fmt.Sprintf("%q", s.Exports["URL"]))
+			}
+		}
+	}
+
+	return nil
+}
+
 func (r *Release) resolveLinks(app App, manifest *Manifest) (Manifest, error) {
 	m := *manifest
 
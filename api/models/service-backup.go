@@ -0,0 +1,263 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/convox/rack/client"
+)
+
+type ServiceBackup client.ServiceBackup
+type ServiceBackups []ServiceBackup
+
+// Backup takes a snapshot of the database of a service
+func (s *Service) Backup() (*ServiceBackup, error) {
+	instance, err := s.databaseInstance()
+	if err != nil {
+		return nil, err
+	}
+
+	id := fmt.Sprintf("%s-%s", strings.ToLower(s.StackName()), time.Now().UTC().Format("20060102150405"))
+
+	res, err := RDS().CreateDBSnapshot(&rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instance),
+		DBSnapshotIdentifier: aws.String(id),
+		Tags: []*rds.Tag{
+			{Key: aws.String("Rack"), Value: aws.String(os.Getenv("RACK"))},
+			{Key: aws.String("Service"), Value: aws.String(s.Name)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b := s.backupFromSnapshot(res.DBSnapshot)
+
+	NotifySuccess("service:backup", map[string]string{
+		"name":   s.Name,
+		"type":   s.Type,
+		"backup": b.Id,
+	})
+
+	return &b, nil
+}
+
+// Backups returns the snapshots of the database of a service, newest first. Snapshots
+// taken before the database was replaced by a restore and the final snapshot of a
+// deleted service are included.
+func (s *Service) Backups() (ServiceBackups, error) {
+	backups := ServiceBackups{}
+
+	err := RDS().DescribeDBSnapshotsPages(&rds.DescribeDBSnapshotsInput{
+		SnapshotType: aws.String("manual"),
+	}, func(res *rds.DescribeDBSnapshotsOutput, last bool) bool {
+		for _, snapshot := range res.DBSnapshots {
+			if s.ownsSnapshot(snapshot) {
+				backups = append(backups, s.backupFromSnapshot(snapshot))
+			}
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(backups)
+
+	return backups, nil
+}
+
+// GetBackup returns a backup of the service
+func (s *Service) GetBackup(id string) (*ServiceBackup, error) {
+	backups, err := s.Backups()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range backups {
+		if b.Id == id {
+			return &b, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no such backup for %s: %s", s.Name, id)
+}
+
+// Restore replaces the database of a service with one created from a backup. The
+// restored database has a new endpoint, so once the update finishes the apps linked to
+// the service are given its new URL and promoted. The restore is reported as a
+// service:restore event.
+func (s *Service) Restore(id string) error {
+	if _, err := s.availableBackup(id); err != nil {
+		return err
+	}
+
+	if err := s.Update(map[string]string{"Snapshot": id}); err != nil {
+		return err
+	}
+
+	go s.relinkRestored(id)
+
+	return nil
+}
+
+func (s *Service) relinkRestored(id string) {
+	data := map[string]string{
+		"name":   s.Name,
+		"type":   s.Type,
+		"backup": id,
+	}
+
+	err := CloudFormation().WaitUntilStackUpdateComplete(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(s.StackName()),
+	})
+	if err != nil {
+		NotifyError("service:restore", fmt.Errorf("unable to restore %s: %s", s.Name, err), data)
+		return
+	}
+
+	updated, err := GetService(s.Name)
+	if err != nil {
+		NotifyError("service:restore", err, data)
+		return
+	}
+
+	relinks, err := s.relinkApps(updated.Exports["URL"], true)
+
+	relinkData(data, relinks)
+
+	if err != nil {
+		NotifyError("service:restore", err, data)
+		return
+	}
+
+	NotifySuccess("service:restore", data)
+}
+
+// Clone creates a service of the same type and parameters from a backup
+func (s *Service) Clone(name, id string) (*Service, error) {
+	if _, err := s.availableBackup(id); err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{}
+
+	for key, value := range s.Parameters {
+		params[key] = value
+	}
+
+	params["Snapshot"] = id
+
+	clone := &Service{
+		Name:       name,
+		Type:       s.Type,
+		Parameters: params,
+	}
+
+	if err := clone.Create(); err != nil {
+		return nil, err
+	}
+
+	return clone, nil
+}
+
+// FinalSnapshot returns true when deleting the service snapshots its database first
+func (s *Service) FinalSnapshot() (bool, error) {
+	res, err := CloudFormation().GetTemplate(&cloudformation.GetTemplateInput{
+		StackName: aws.String(s.StackName()),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var t struct {
+		Resources map[string]struct {
+			Type           string
+			DeletionPolicy string
+		}
+	}
+
+	if err := json.Unmarshal([]byte(*res.TemplateBody), &t); err != nil {
+		return false, err
+	}
+
+	for _, r := range t.Resources {
+		if r.Type == "AWS::RDS::DBInstance" && r.DeletionPolicy != "Snapshot" {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (s *Service) availableBackup(id string) (*ServiceBackup, error) {
+	b, err := s.GetBackup(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.Status != "available" {
+		return nil, fmt.Errorf("backup %s is %s, wait for it to be available", id, b.Status)
+	}
+
+	return b, nil
+}
+
+// databaseInstance returns the identifier of the database instance of the service,
+// which changes when it is restored
+func (s *Service) databaseInstance() (string, error) {
+	res, err := CloudFormation().DescribeStackResource(&cloudformation.DescribeStackResourceInput{
+		LogicalResourceId: aws.String("Instance"),
+		StackName:         aws.String(s.StackName()),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return *res.StackResourceDetail.PhysicalResourceId, nil
+}
+
+// ownsSnapshot returns true for snapshots of the database instance the service was
+// created with and the instances restores replaced it with, which cloudformation names
+// after the stack and the Instance resource
+func (s *Service) ownsSnapshot(snapshot *rds.DBSnapshot) bool {
+	stack := strings.ToLower(s.StackName())
+	instance := strings.ToLower(aws.StringValue(snapshot.DBInstanceIdentifier))
+
+	return instance == stack || strings.HasPrefix(instance, stack+"-instance-")
+}
+
+func (s *Service) backupFromSnapshot(snapshot *rds.DBSnapshot) ServiceBackup {
+	b := ServiceBackup{
+		Id:      aws.StringValue(snapshot.DBSnapshotIdentifier),
+		Service: s.Name,
+		Status:  aws.StringValue(snapshot.Status),
+		Size:    aws.Int64Value(snapshot.AllocatedStorage),
+	}
+
+	if snapshot.SnapshotCreateTime != nil {
+		b.Created = *snapshot.SnapshotCreateTime
+	}
+
+	return b
+}
+
+func (bs ServiceBackups) Len() int {
+	return len(bs)
+}
+
+func (bs ServiceBackups) Less(i, j int) bool {
+	return bs[i].Created.After(bs[j].Created)
+}
+
+func (bs ServiceBackups) Swap(i, j int) {
+	bs[i], bs[j] = bs[j], bs[i]
+}
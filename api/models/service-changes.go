@@ -0,0 +1,123 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/convox/rack/client"
+)
+
+type ServiceChange client.ServiceChange
+type ServiceChanges []ServiceChange
+
+// statefulResources are the resource types that lose their data when replaced
+var statefulResources = map[string]bool{
+	"AWS::AmazonMQ::Broker":              true,
+	"AWS::Elasticsearch::Domain":         true,
+	"AWS::ElastiCache::CacheCluster":     true,
+	"AWS::ElastiCache::ReplicationGroup": true,
+	"AWS::RDS::DBInstance":               true,
+	"AWS::S3::Bucket":                    true,
+	"AWS::SQS::Queue":                    true,
+}
+
+// Preview returns the changes an update would make to the resources of a service using a
+// change set, which is deleted afterwards
+func (s *Service) Preview(changes map[string]string) (ServiceChanges, error) {
+	req, err := s.updateRequest(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("preview-%d", time.Now().Unix())
+
+	_, err = CloudFormation().CreateChangeSet(&cloudformation.CreateChangeSetInput{
+		Capabilities:  req.Capabilities,
+		ChangeSetName: aws.String(name),
+		Parameters:    req.Parameters,
+		StackName:     req.StackName,
+		TemplateBody:  req.TemplateBody,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer CloudFormation().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(name),
+		StackName:     req.StackName,
+	})
+
+	timeout := time.After(2 * time.Minute)
+	tick := time.Tick(2 * time.Second)
+
+	for {
+		select {
+		case <-tick:
+		case <-timeout:
+			return nil, fmt.Errorf("timeout waiting for the changes to %s", s.Name)
+		}
+
+		res, err := CloudFormation().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+			ChangeSetName: aws.String(name),
+			StackName:     req.StackName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		switch *res.Status {
+		case cloudformation.ChangeSetStatusCreateComplete:
+			return serviceChanges(res.Changes), nil
+		case cloudformation.ChangeSetStatusFailed:
+			// a change set for an update without changes fails instead of being empty
+			if reason := aws.StringValue(res.StatusReason); strings.Contains(reason, "didn't contain changes") {
+				return ServiceChanges{}, nil
+			}
+
+			return nil, fmt.Errorf("unable to preview changes: %s", aws.StringValue(res.StatusReason))
+		}
+	}
+}
+
+// Replacing returns the stateful resources the changes replace or remove, which may be
+// conditional on property values only known during the update
+func (cs ServiceChanges) Replacing() []string {
+	resources := []string{}
+
+	for _, c := range cs {
+		if !c.Stateful {
+			continue
+		}
+
+		if c.Action == cloudformation.ChangeActionRemove || c.Replacement == cloudformation.ReplacementTrue || c.Replacement == cloudformation.ReplacementConditional {
+			resources = append(resources, c.Resource)
+		}
+	}
+
+	return resources
+}
+
+func serviceChanges(changes []*cloudformation.Change) ServiceChanges {
+	cs := ServiceChanges{}
+
+	for _, c := range changes {
+		rc := c.ResourceChange
+
+		if rc == nil {
+			continue
+		}
+
+		cs = append(cs, ServiceChange{
+			Action:      aws.StringValue(rc.Action),
+			Resource:    aws.StringValue(rc.LogicalResourceId),
+			Type:        aws.StringValue(rc.ResourceType),
+			Replacement: aws.StringValue(rc.Replacement),
+			Stateful:    statefulResources[aws.StringValue(rc.ResourceType)],
+		})
+	}
+
+	return cs
+}
@@ -0,0 +1,235 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/convox/rack/api/provider"
+	"github.com/convox/rack/api/structs"
+)
+
+// serviceRelink is an app whose environment was rewritten for a service, kept to roll it back
+type serviceRelink struct {
+	App      string
+	Env      Environment
+	Release  string
+	Promoted bool
+}
+
+// RotateCredentials sets a new password on a service, waits for the stack to update and
+// rewrites its URL in the environment of every linked app with a new release, promoting
+// them if asked to. When an app can not be updated the apps already changed and the
+// password are rolled back. The rotation is reported as a single service:rotate event.
+func (s *Service) RotateCredentials(promote bool) error {
+	data := map[string]string{
+		"name": s.Name,
+		"type": s.Type,
+	}
+
+	relinks, err := s.rotateCredentials(promote)
+	if err != nil {
+		NotifyError("service:rotate", err, data)
+		return err
+	}
+
+	relinkData(data, relinks)
+
+	NotifySuccess("service:rotate", data)
+
+	return nil
+}
+
+func (s *Service) rotateCredentials(promote bool) ([]serviceRelink, error) {
+	old := s.Parameters["Password"]
+
+	if err := s.updateAndWait(map[string]string{"Password": generateId("", 30)}); err != nil {
+		return nil, fmt.Errorf("unable to update %s: %s", s.Name, err)
+	}
+
+	updated, err := GetService(s.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	relinks, err := s.relinkApps(updated.Exports["URL"], promote)
+	if err != nil {
+		return nil, s.rollbackCredentials(old, relinks, err)
+	}
+
+	return relinks, nil
+}
+
+// relinkApps rewrites the URL of the service in every app linked to it. When an app can
+// not be updated the apps changed so far are returned with the error.
+func (s *Service) relinkApps(url string, promote bool) ([]serviceRelink, error) {
+	relinks := []serviceRelink{}
+
+	apps, err := ListApps()
+	if err != nil {
+		return relinks, err
+	}
+
+	for _, a := range apps {
+		// apps still creating have no settings to link through
+		if a.Status != "running" && a.Status != "updating" {
+			continue
+		}
+
+		links, err := provider.LinkList(a.Name)
+		if err != nil {
+			return relinks, err
+		}
+
+		r, err := s.relinkApp(a.Name, links, url, promote)
+		if r != nil {
+			relinks = append(relinks, *r)
+		}
+		if err != nil {
+			return relinks, fmt.Errorf("unable to update %s: %s", a.Name, err)
+		}
+	}
+
+	return relinks, nil
+}
+
+// relinkApp rewrites the URL for the links of an app to the service and creates a
+// release, which also resolves links scoped to processes again
+func (s *Service) relinkApp(app string, links structs.Links, url string, promote bool) (*serviceRelink, error) {
+	linked := false
+
+	env, err := GetEnvironment(app)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := Environment{}
+
+	for key, value := range env {
+		changed[key] = value
+	}
+
+	for _, l := range links {
+		if l.Service != s.Name {
+			continue
+		}
+
+		linked = true
+
+		if l.Process == "" {
+			changed[l.Variable] = url
+		}
+	}
+
+	if !linked {
+		return nil, nil
+	}
+
+	id, err := PutEnvironment(app, changed)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &serviceRelink{App: app, Env: env, Release: id}
+
+	if !promote {
+		return r, nil
+	}
+
+	release, err := GetRelease(app, id)
+	if err != nil {
+		return r, err
+	}
+
+	if err := release.Promote(); err != nil {
+		return r, err
+	}
+
+	r.Promoted = true
+
+	if err := waitForAppUpdate(app); err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+// relinkData adds the apps and releases of relinks to the data of an event
+func relinkData(data map[string]string, relinks []serviceRelink) {
+	apps := []string{}
+	ids := []string{}
+
+	for _, r := range relinks {
+		apps = append(apps, r.App)
+		ids = append(ids, r.Release)
+	}
+
+	data["apps"] = strings.Join(apps, ",")
+	data["releases"] = strings.Join(ids, ",")
+}
+
+// rollbackCredentials restores the environment of relinked apps and the old password,
+// returning the error that caused the rollback
+func (s *Service) rollbackCredentials(password string, relinks []serviceRelink, cause error) error {
+	failed := []string{}
+
+	for _, r := range relinks {
+		id, err := PutEnvironment(r.App, r.Env)
+		if err != nil {
+			failed = append(failed, r.App)
+			continue
+		}
+
+		if r.Promoted {
+			release, err := GetRelease(r.App, id)
+			if err == nil {
+				err = release.Promote()
+			}
+			if err == nil {
+				err = waitForAppUpdate(r.App)
+			}
+			if err != nil {
+				failed = append(failed, r.App)
+			}
+		}
+	}
+
+	if err := s.updateAndWait(map[string]string{"Password": password}); err != nil {
+		failed = append(failed, s.Name)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%s, rollback failed for: %s", cause, strings.Join(failed, ", "))
+	}
+
+	return fmt.Errorf("%s, rolled back", cause)
+}
+
+// updateAndWait updates the service stack and waits for the update to finish
+func (s *Service) updateAndWait(changes map[string]string) error {
+	if err := s.Update(changes); err != nil {
+		return err
+	}
+
+	for key, value := range changes {
+		s.Parameters[key] = value
+	}
+
+	return CloudFormation().WaitUntilStackUpdateComplete(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(s.StackName()),
+	})
+}
+
+// waitForAppUpdate waits for the stack update of a promoted release to finish, so the
+// next app is only changed once this one runs with its new environment
+func waitForAppUpdate(name string) error {
+	app, err := GetApp(name)
+	if err != nil {
+		return err
+	}
+
+	return CloudFormation().WaitUntilStackUpdateComplete(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(app.StackName()),
+	})
+}
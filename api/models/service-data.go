@@ -0,0 +1,189 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fsouza/go-dockerclient"
+)
+
+// serviceDumper runs the client tools of a database in a one-off container on an instance
+// of the rack, which can reach services in private subnets
+type serviceDumper struct {
+	Repository string
+	Tag        string
+	Export     string
+	Import     string
+
+	// Env returns the container environment from the outputs of the service stack
+	Env func(outputs map[string]string) []string
+}
+
+var serviceDumpers = map[string]serviceDumper{
+	"mysql": {
+		Repository: "mysql",
+		Tag:        "5.6",
+		Export:     `mysqldump --single-transaction --routines --triggers -h "$MYSQL_HOST" -u "$MYSQL_USER" "$MYSQL_DATABASE"`,
+		Import:     `mysql -h "$MYSQL_HOST" -u "$MYSQL_USER" "$MYSQL_DATABASE"`,
+		Env: func(outputs map[string]string) []string {
+			return []string{
+				fmt.Sprintf("MYSQL_HOST=%s", outputs["Port3306TcpAddr"]),
+				fmt.Sprintf("MYSQL_TCP_PORT=%s", outputs["Port3306TcpPort"]),
+				fmt.Sprintf("MYSQL_USER=%s", outputs["EnvMysqlUsername"]),
+				fmt.Sprintf("MYSQL_PWD=%s", outputs["EnvMysqlPassword"]),
+				fmt.Sprintf("MYSQL_DATABASE=%s", outputs["EnvMysqlDatabase"]),
+			}
+		},
+	},
+	"postgres": {
+		Repository: "postgres",
+		Tag:        "9.4",
+		Export:     "pg_dump --no-owner --no-acl",
+		Import:     "psql --quiet --set ON_ERROR_STOP=1",
+		Env: func(outputs map[string]string) []string {
+			return []string{
+				fmt.Sprintf("PGHOST=%s", outputs["Port5432TcpAddr"]),
+				fmt.Sprintf("PGPORT=%s", outputs["Port5432TcpPort"]),
+				fmt.Sprintf("PGUSER=%s", outputs["EnvPostgresUsername"]),
+				fmt.Sprintf("PGPASSWORD=%s", outputs["EnvPostgresPassword"]),
+				fmt.Sprintf("PGDATABASE=%s", outputs["EnvPostgresDatabase"]),
+			}
+		},
+	},
+}
+
+// Exportable returns true for services whose data can be exported and imported
+func (s *Service) Exportable() bool {
+	_, ok := serviceDumpers[s.Type]
+	return ok
+}
+
+// Export writes a dump of the database of a service
+func (s *Service) Export(w io.Writer) error {
+	d, ok := serviceDumpers[s.Type]
+	if !ok {
+		return fmt.Errorf("can not export %s services", s.Type)
+	}
+
+	return s.runDumper(d, d.Export, nil, w)
+}
+
+// Import loads a dump into the database of a service. The dump is read until the end of
+// r or a StatusCodePrefix marker, which lets clients end the input of a websocket.
+func (s *Service) Import(r io.Reader, w io.Writer) error {
+	d, ok := serviceDumpers[s.Type]
+	if !ok {
+		return fmt.Errorf("can not import %s services", s.Type)
+	}
+
+	return s.runDumper(d, d.Import, &markedReader{r: r}, w)
+}
+
+func (s *Service) runDumper(d serviceDumper, command string, in io.Reader, out io.Writer) error {
+	dc, err := Docker("")
+	if err != nil {
+		return err
+	}
+
+	err = dc.PullImage(docker.PullImageOptions{
+		Repository: d.Repository,
+		Tag:        d.Tag,
+	}, docker.AuthConfiguration{})
+	if err != nil {
+		return err
+	}
+
+	res, err := dc.CreateContainer(docker.CreateContainerOptions{
+		Config: &docker.Config{
+			AttachStdin:  in != nil,
+			AttachStdout: true,
+			AttachStderr: true,
+			Env:          d.Env(s.Outputs),
+			OpenStdin:    in != nil,
+			StdinOnce:    in != nil,
+			Cmd:          []string{"sh", "-c", command},
+			Image:        fmt.Sprintf("%s:%s", d.Repository, d.Tag),
+			Labels: map[string]string{
+				"com.convox.rack.type":    "oneoff",
+				"com.convox.rack.service": s.Name,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	defer dc.RemoveContainer(docker.RemoveContainerOptions{ID: res.ID, Force: true})
+
+	// without a tty stdout carries only the dump and errors are collected separately
+	var stderr bytes.Buffer
+
+	success := make(chan struct{})
+	attached := make(chan error, 1)
+
+	go func() {
+		attached <- dc.AttachToContainer(docker.AttachToContainerOptions{
+			Container:    res.ID,
+			InputStream:  in,
+			OutputStream: out,
+			ErrorStream:  &stderr,
+			Stream:       true,
+			Stdin:        in != nil,
+			Stdout:       true,
+			Stderr:       true,
+			Success:      success,
+		})
+	}()
+
+	select {
+	case <-success:
+		success <- struct{}{}
+	case err := <-attached:
+		return err
+	}
+
+	if err := dc.StartContainer(res.ID, nil); err != nil {
+		return err
+	}
+
+	code, err := dc.WaitContainer(res.ID)
+	if err != nil {
+		return err
+	}
+
+	// wait for the output to drain
+	<-attached
+
+	if code != 0 {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s", msg)
+		}
+
+		return fmt.Errorf("%s exited with code %d", strings.Fields(command)[0], code)
+	}
+
+	return nil
+}
+
+// markedReader reads until the end of r or a read starting with StatusCodePrefix
+type markedReader struct {
+	r    io.Reader
+	done bool
+}
+
+func (mr *markedReader) Read(p []byte) (int, error) {
+	if mr.done {
+		return 0, io.EOF
+	}
+
+	n, err := mr.r.Read(p)
+
+	if bytes.HasPrefix(p[0:n], []byte(StatusCodePrefix)) {
+		mr.done = true
+		return 0, io.EOF
+	}
+
+	return n, err
+}
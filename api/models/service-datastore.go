@@ -13,7 +13,13 @@ func (s *Service) CreateDatastore() (*cloudformation.CreateStackInput, error) {
 		return nil, err
 	}
 
-	if s.Type != "redis" {
+	fp, err := formationParameters(formation)
+	if err != nil {
+		return nil, err
+	}
+
+	// generate a password for datastores that take one
+	if _, ok := fp["Password"]; ok && s.Parameters["Password"] == "" {
 		s.Parameters["Password"] = generateId("", 30)
 	}
 
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/convox/rack/api/provider"
+	"github.com/convox/rack/client"
+)
+
+// LoadLinks adds the apps linked to each service. Services that subscribe to an app
+// record it in their stack outputs, other links are kept with the app.
+func (ss Services) LoadLinks() error {
+	index := map[string]int{}
+
+	for i, s := range ss {
+		index[s.Name] = i
+
+		ss[i].Links = client.Links{}
+
+		for key := range s.Outputs {
+			if strings.HasSuffix(key, "Link") {
+				n := DashName(key)
+				ss[i].Links = append(ss[i].Links, client.Link{App: n[:len(n)-5], Service: s.Name})
+			}
+		}
+	}
+
+	apps, err := ListApps()
+	if err != nil {
+		return err
+	}
+
+	for _, a := range apps {
+		// apps still creating have no settings to link through
+		if a.Status != "running" && a.Status != "updating" {
+			continue
+		}
+
+		links, err := provider.LinkList(a.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, l := range links {
+			if i, ok := index[l.Service]; ok {
+				ss[i].Links = append(ss[i].Links, client.Link(l))
+			}
+		}
+	}
+
+	for _, s := range ss {
+		sort.Sort(s.Links)
+	}
+
+	return nil
+}
+
+// Dependents returns the links of apps to the service
+func (s *Service) Dependents() (client.Links, error) {
+	ss := Services{*s}
+
+	if err := ss.LoadLinks(); err != nil {
+		return nil, err
+	}
+
+	return ss[0].Links, nil
+}
+
+// GetSystemGraph returns the apps and services of the rack and the links between them
+func GetSystemGraph() (*client.SystemGraph, error) {
+	apps, err := ListApps()
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := ListServices()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.LoadLinks(); err != nil {
+		return nil, err
+	}
+
+	g := &client.SystemGraph{
+		Apps:     []string{},
+		Services: []client.SystemGraphService{},
+		Links:    client.Links{},
+	}
+
+	for _, a := range apps {
+		g.Apps = append(g.Apps, a.Name)
+	}
+
+	sort.Strings(g.Apps)
+
+	// services are sorted by name
+	for _, s := range services {
+		g.Services = append(g.Services, client.SystemGraphService{Name: s.Name, Type: s.Type})
+		g.Links = append(g.Links, s.Links...)
+	}
+
+	return g, nil
+}
+
+// UnlinkDependents removes the links of every app to a service before it is deleted.
+// Each app gets a release without the service like when it is unlinked.
+func UnlinkDependents(service string) error {
+	apps, err := ListApps()
+	if err != nil {
+		return err
+	}
+
+	for _, a := range apps {
+		if a.Status != "running" && a.Status != "updating" {
+			continue
+		}
+
+		links, err := provider.LinkList(a.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, l := range links {
+			if l.Service != service {
+				continue
+			}
+
+			if _, err := provider.ServiceUnlink(service, a.Name, l.Process); err != nil {
+				return fmt.Errorf("unable to unlink %s: %s", a.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -2,13 +2,13 @@ package models
 
 import (
 	"fmt"
-	"net/url"
 	"os"
 	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/convox/rack/api/structs"
 	"github.com/convox/rack/client"
 )
 
@@ -189,12 +189,24 @@ func (s *Service) Delete() error {
 // makes no guarantees of service uptime during update. In fact, most datastore
 // updates guarantee resource replacement which will cause database downtime.
 func (s *Service) Update(changes map[string]string) error {
+	req, err := s.updateRequest(changes)
+	if err != nil {
+		return err
+	}
+
+	_, err = CloudFormation().UpdateStack(req)
+
+	return err
+}
+
+// updateRequest returns the stack update applying changes to the existing parameters
+func (s *Service) updateRequest(changes map[string]string) (*cloudformation.UpdateStackInput, error) {
 	var req *cloudformation.UpdateStackInput
 	var err error
 
 	switch s.Type {
 	case "webhook":
-		return fmt.Errorf("can not update webhook")
+		return nil, fmt.Errorf("can not update webhook")
 	case "s3", "sns", "sqs":
 		req, err = s.UpdateIAMService()
 	default:
@@ -202,7 +214,7 @@ func (s *Service) Update(changes map[string]string) error {
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	params := map[string]string{}
@@ -220,7 +232,7 @@ func (s *Service) Update(changes map[string]string) error {
 	fp, err := formationParameters(*req.TemplateBody)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// remove params that don't exist in the template
@@ -238,13 +250,16 @@ func (s *Service) Update(changes map[string]string) error {
 		})
 	}
 
-	_, err = CloudFormation().UpdateStack(req)
-
-	return err
+	return req, nil
 }
 
 func (s *Service) Formation() (string, error) {
-	data, err := buildTemplate(fmt.Sprintf("service/%s", s.Type), "service", nil)
+	t, err := structs.GetServiceType(s.Type)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := buildTemplate(t.Template, "service", nil)
 
 	if err != nil {
 		return "", err
@@ -279,28 +294,9 @@ func serviceFromStack(stack *cloudformation.Stack) *Service {
 	}
 
 	if humanStatus(*stack.StackStatus) == "running" {
-		switch tags["Service"] {
-		case "mysql":
-			exports["URL"] = fmt.Sprintf("mysql://%s:%s@%s:%s/%s", outputs["EnvMysqlUsername"], outputs["EnvMysqlPassword"], outputs["Port3306TcpAddr"], outputs["Port3306TcpPort"], outputs["EnvMysqlDatabase"])
-		case "papertrail":
-			exports["URL"] = parameters["Url"]
-		case "postgres":
-			exports["URL"] = fmt.Sprintf("postgres://%s:%s@%s:%s/%s", outputs["EnvPostgresUsername"], outputs["EnvPostgresPassword"], outputs["Port5432TcpAddr"], outputs["Port5432TcpPort"], outputs["EnvPostgresDatabase"])
-		case "redis":
-			exports["URL"] = fmt.Sprintf("redis://%s:%s/%s", outputs["Port6379TcpAddr"], outputs["Port6379TcpPort"], outputs["EnvRedisDatabase"])
-		case "s3":
-			exports["URL"] = fmt.Sprintf("s3://%s:%s@%s", outputs["AccessKey"], url.QueryEscape(outputs["SecretAccessKey"]), outputs["Bucket"])
-		case "sns":
-			exports["URL"] = fmt.Sprintf("sns://%s:%s@%s", outputs["AccessKey"], url.QueryEscape(outputs["SecretAccessKey"]), outputs["Topic"])
-		case "sqs":
-			if u, err := url.Parse(outputs["Queue"]); err == nil {
-				u.Scheme = "sqs"
-				u.User = url.UserPassword(outputs["AccessKey"], url.QueryEscape(outputs["SecretAccessKey"]))
-				exports["URL"] = u.String()
-			}
-		case "webhook":
-			if parsedUrl, err := url.Parse(parameters["Url"]); err == nil {
-				exports["URL"] = parsedUrl.Query().Get("endpoint")
+		if t, err := structs.GetServiceType(tags["Service"]); err == nil && t.URL != nil {
+			if u := t.URL(outputs, parameters); u != "" {
+				exports["URL"] = u
 			}
 		}
 	}
@@ -1,8 +1,12 @@
 package models
 
 import (
+	"io"
+	"io/ioutil"
+	"strings"
 	"testing"
 
+	"github.com/convox/rack/api/structs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,3 +23,56 @@ func TestCFParams(t *testing.T) {
 	assert.Equal(t, "false", params["EncryptedStorage"])
 	assert.Equal(t, "TEST", params["TestTestTestTest"])
 }
+
+func TestServiceTypeParameters(t *testing.T) {
+	for _, st := range structs.ListServiceTypes() {
+		if st.Provider {
+			continue
+		}
+
+		s := &Service{Type: st.Name}
+
+		formation, err := s.Formation()
+		if !assert.Nil(t, err, st.Name) {
+			continue
+		}
+
+		fp, err := formationParameters(formation)
+		if !assert.Nil(t, err, st.Name) {
+			continue
+		}
+
+		for _, p := range st.Parameters {
+			tp, ok := fp[AwsCamelize(p.Name)]
+
+			if assert.True(t, ok, "%s: --%s is not a template parameter", st.Name, p.Name) && p.Default != "" {
+				assert.Equal(t, p.Default, tp.Default, "%s: --%s default", st.Name, p.Name)
+			}
+		}
+	}
+}
+
+func TestServiceImportStopsAtMarker(t *testing.T) {
+	in := io.MultiReader(
+		strings.NewReader("CREATE TABLE a ();\n"),
+		strings.NewReader(StatusCodePrefix),
+		strings.NewReader("ignored"),
+	)
+
+	data, err := ioutil.ReadAll(&markedReader{r: in})
+
+	if assert.Nil(t, err) {
+		assert.Equal(t, "CREATE TABLE a ();\n", string(data))
+	}
+}
+
+func TestServiceChangesReplacing(t *testing.T) {
+	cs := ServiceChanges{
+		{Action: "Modify", Resource: "Instance", Type: "AWS::RDS::DBInstance", Replacement: "Conditional", Stateful: true},
+		{Action: "Modify", Resource: "SecurityGroup", Type: "AWS::EC2::SecurityGroup", Replacement: "True"},
+		{Action: "Modify", Resource: "Queue", Type: "AWS::SQS::Queue", Replacement: "False", Stateful: true},
+		{Action: "Remove", Resource: "Bucket", Type: "AWS::S3::Bucket", Stateful: true},
+	}
+
+	assert.Equal(t, []string{"Instance", "Bucket"}, cs.Replacing())
+}
@@ -1,8 +1,11 @@
 // Code generated by go-bindata.
 // sources:
 // models/templates/app.tmpl
+// models/templates/service/elasticsearch.tmpl
+// models/templates/service/memcached.tmpl
 // models/templates/service/mysql.tmpl
 // models/templates/service/postgres.tmpl
+// models/templates/service/rabbitmq.tmpl
 // models/templates/service/redis.tmpl
 // models/templates/service/s3.tmpl
 // models/templates/service/sns.tmpl
@@ -75,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesAppTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x3c\x7f\x73\xdb\x38\x76\x7f\x37\x9f\x02\xc3\xd9\x19\x79\x6f\x25\xd9\x4e\xee\xb6\x57\x6d\x73\x33\x8e\xac\x6c\xb4\xeb\xd8\xae\xa4\xe4\xa6\x9b\x78\x32\x34\x05\x5b\x5c\x53\xa4\x96\x20\x6d\x6b\x3d\xfa\xee\x7d\x0f\x3f\x48\x00\x04\x45\x5a\x76\xd2\x76\xda\xb4\x77\x67\x11\x0f\x0f\x0f\x0f\x0f\x0f\xef\x17\xf0\xf0\x40\xe6\xf4\x2a\x8c\x29\xf1\xfc\xd5\xca\x23\x9b\xcd\x0b\x42\x1e\xe0\x3f\x84\x78\x47\xff\x9c\xce\xe8\x72\x15\xf9\x19\x7d\x9b\xa4\x4b\x3f\xfb\x48\x53\x16\x26\xb1\x47\x06\xc4\x7b\x79\x70\x78\xd0\x3b\xf8\x37\xf8\x7f\xaf\x2b\xc0\x87\x49\x3c\x0f\x33\x68\x67\xde\x40\xa2\x00\x54\x0f\x24\x93\x38\x88\x77\xe9\x47\x7e\x1c\xd0\xb4\x17\x94\xa0\xa4\x2f\xc6\xac\x00\xaf\xd2\x24\xa0\x8c\xb5\x82\x4d\xe9\x75\xc8\xb2\x74\x5d\x07\xec\x9d\xa7\xe1\x2d\x40\x22\x61\xc4\x7b\x1b\x0f\x06\xa3\x3f\x72\x3f\x42\x42\x3f\xe1\x97\x09\xbd\x82\x3f\x0b\x28\xb2\xe9\x12\xef\x3f\x29\xe0\xb8\x80\x3f\x15\x8e\x37\x40\xfc\xcd\x94\x06\x79\x1a\x66\xeb\x9f\xd3\x24\x5f\x21\x23\x1e\x74\x74\xf0\xfb\xd3\x03\xc7\x86\x2c\x32\x61\x11\xa7\x77\x21\x28\x92\x48\xbd\x73\x3f\xf5\x97\x34\x03\xb6\x72\x54\x5b\x79\xb6\x42\xd8\x16\xfc\x32\xe0\x14\xed\xc3\x28\x67\x30\x8c\xb6\x30\xf0\x71\xb6\x5e\x51\x41\x68\x96\x86\xf1\xb5\xd7\x2d\x9b\x8e\xe9\x95\x9f\x47\x19\x6f\x35\xbf\xb3\x20\x0d\x57\x99\x92\x02\x4f\x36\x95\x5c\x3a\xa6\xab\x28\x59\x2f\x69\x9c\xbd\xf7\xef\xc3\x65\xbe\x74\x8c\x09\x1d\x4f\xf3\xe5\x25\xd0\xe3\x18\x92\xcb\xd6\x41\xdd\xa0\xd0\x2a\xf1\x92\x15\x4d\x03\x18\xc6\xbf\xa6\x24\xb9\x22\x72\xfa\x94\x91\x2c\x21\x37\x94\xae\x48\x9a\xc7\x31\x4c\x8b\xdc\x2d\xc2\x88\x82\x94\x23\x5d\x38\xcd\x6d\x24\x87\xf1\x8e\x24\x1f\x6e\x27\x59\xe0\x7d\x3e\x92\x47\xf1\x6d\x98\x26\x31\xd2\xec\x26\xb6\x7e\x49\xb7\xac\xa8\x73\x41\x7f\xa5\xeb\xaf\x3d\x84\xb6\x3b\xdb\x0d\x63\xe0\x1b\xa6\x14\x37\x40\x18\x83\x80\xc7\x7e\x44\xa2\xc4\x9f\x13\xb5\x6d\x18\x7c\x07\x46\x73\xfc\x84\xe5\x97\x31\xcd\x58\x0d\xc9\xa7\x89\xde\x70\x14\x45\xc9\x1d\x9d\x7f\xf4\xa3\x9c\x0a\x3d\xc1\x35\x42\x97\xc3\x91\x8b\xca\x1c\x26\x34\xa2\x3e\x73\xcd\xe1\xb9\x77\xd8\x84\xae\x12\x16\x66\x49\xea\x5a\x97\xa7\x0d\x36\x4d\x72\x10\x50\x12\x24\x73\x4a\xd2\x72\x98\x0a\x09\xa6\x66\x7b\x6e\x2a\x66\x0b\x4a\x4e\xf4\x45\x24\x4c\x8e\x47\xae\x71\x40\x72\x95\xa4\x24\x5b\x84\x8c\xe0\x91\x55\x25\x4e\xae\xb2\x9b\xac\x13\x38\x28\xfe\x1d\x4e\x36\xd0\xd9\xc3\x97\x83\x81\x00\x1e\x0c\xc6\xf3\x7f\xec\x42\xea\xc7\xf3\xa1\x92\xaa\x76\x54\xd5\x8b\xfa\xd7\x21\xce\x12\xfd\x06\x22\xd5\x01\x6f\x50\x67\x6d\xb7\xbd\xc9\xe8\x3f\x3e\x8c\x27\xa3\xe3\xef\xc9\x89\xbf\xbc\x9c\xfb\x64\x08\x67\x4b\xb2\x9c\x25\xab\x30\x20\xef\xfc\x78\x1e\xc1\x8a\xc9\xed\x40\x14\x46\x8d\x4c\x50\x86\x27\x34\xbe\xce\x16\x9c\xc8\x43\xbd\xc9\xda\xf3\x55\xfa\xce\x87\x35\x9c\x2b\x99\x06\x30\xc8\xb1\x5d\x19\xd6\xc0\xa0\xf3\xe1\x70\x7c\x3c\x79\x76\x91\xc7\x91\x11\xb1\x7b\x78\xc3\x66\x78\x0f\x2d\x30\x8a\x2e\xdf\xde\x79\x92\x66\xe7\x69\x92\x25\x41\x12\x99\xb4\x2d\xb2\x6c\x25\xac\x1e\x94\x2d\x1a\xd3\x54\x83\xf3\xde\xcd\x66\xe7\xa8\xd2\xc6\x31\xcb\x70\xa7\xb9\xda\xf8\x5e\xa7\x75\x10\x53\xaf\xe4\x8e\x1c\x8e\x6d\x1f\x6f\xfa\xe4\x01\x8d\x11\xb3\x60\xcb\xfc\x66\xc3\xda\xe9\xc9\xa6\xfa\xc1\xa6\xd3\x13\x7b\xa8\x68\xcb\xd4\x10\xfc\x69\x43\x91\x8d\x73\xbd\x27\x94\x71\xad\x6c\x2c\xb8\xb6\xe5\x26\x49\x54\x73\x72\xf2\x3d\x31\x3e\x7a\x3f\x18\x70\x18\x6d\x26\x30\x38\x98\x22\x59\x48\x4d\x2d\x89\xc7\x1e\x63\xf9\x92\x22\xfc\x79\x12\x85\xc1\xfa\x38\x09\xf2\x8a\x95\x21\xb6\x42\xa1\x2b\xd0\x17\x78\xd9\x03\x77\xe0\xf0\x5f\xb5\x41\x38\xd0\x34\x03\xe5\x23\xfb\x7f\x32\x9a\x88\x85\x8f\x83\x8f\xae\xae\x68\xc0\x0f\x63\x7e\xfc\x5a\xd8\x24\xe9\x61\x1c\x84\x2b\x3f\x12\x4b\x31\xa5\xe9\x6d\x18\x50\x71\x40\x47\x5c\x1f\xf5\xfd\xa5\xff\x67\x12\xfb\x77\xac\x1f\x24\x4b\xc3\x86\xd7\x27\x1a\x48\x85\x06\xfd\x58\xc6\x06\xe5\xc4\xcb\xd3\x5d\xfd\xdb\x18\xbf\xf5\x56\x03\x33\x98\xf3\xa0\xd4\x80\xf8\x7d\xcf\xfc\x8c\x9c\x14\xbc\x36\x79\x60\x73\x40\x40\xae\x4f\xc1\x27\xe0\x3c\x98\x2f\xc1\x6e\x04\xbf\xc6\x87\x53\xb8\xc2\x0b\xaf\x61\x81\x38\x4c\x9b\x45\xe2\x80\xc6\x42\x21\x63\x2b\x4b\xa1\xb1\xcc\xfb\x0b\xfe\x54\x82\x29\x3e\x90\x4d\x03\xdb\xf4\x5f\x25\xe4\xa6\xa2\x62\x35\xd1\xde\x22\xd6\xe2\xe8\x19\x0c\xde\xe6\xb1\xa0\xaa\x95\x74\x0f\xc1\xb0\xa9\x4a\xf2\xf4\xd5\x9b\x3c\xb8\xa1\x59\xe9\x1d\xfe\x92\x84\x52\x34\x7a\x30\x53\xf8\x1f\xf0\x2b\x6f\x93\x7b\xf8\xbb\x74\x16\x39\x19\x13\x70\x3c\x51\x85\xc3\xe4\xab\x72\x06\x88\xa5\xf1\x6c\x63\x15\x48\x53\x71\x46\xee\x1b\x68\x0b\x1f\x1b\xfd\xc5\xfd\x2b\xee\x77\xc3\xef\xfe\x9f\xe1\xca\x13\x83\xd4\x8a\x9f\x3c\x7b\x11\x8b\xdc\x09\xf4\x5e\xd8\xc4\xa6\x3c\xbe\xa7\x4b\xb0\xea\xa6\xe1\x9f\x9c\x9d\x87\x2f\xff\x6e\x36\x2b\x85\x22\x88\xfe\x99\x66\x47\x99\x90\x8a\x8a\xd6\x41\x99\x48\xe3\xca\x0e\xf3\x26\x79\x9c\x85\x42\x86\x63\xe0\xf8\xef\xcc\x1c\x60\x06\x6d\x49\xce\x65\xeb\xd5\x81\x57\x2f\x0a\x6e\x3f\x38\x2d\xf4\x61\xad\x2b\x1c\x80\x6b\xf4\x7b\x72\xd9\x06\x54\x79\xcd\x8f\x00\x65\x42\xe5\xb4\x09\x47\xd8\x58\x5d\xc0\xca\xb6\xf5\x6a\x90\xb1\x4c\x84\x24\xcc\x53\xe1\x2c\xcf\x56\x79\xd6\x1c\x69\x49\x24\x5c\xe3\xac\x1a\x01\x8b\x49\x99\x90\xa5\x4b\x90\x65\x96\x59\x82\xfa\x07\xdd\x27\x21\x4c\x52\xbe\x0b\x38\xfb\xb8\x7b\x81\xff\x81\x11\x69\x3c\xe7\x78\xb5\xc8\x94\x2b\x14\x24\x02\x55\x0f\x24\xf5\x63\xf0\xa6\xbf\xbb\x21\x83\xd7\xa4\x3f\x8a\x81\x40\x54\x9f\x4c\x4d\x42\x44\x6d\x00\x2e\x5f\x81\x4e\x40\xb8\xcd\xa6\x3c\x33\xaa\xc1\x20\xdc\x9a\x9e\x26\xd1\xe0\x69\xfb\x97\x11\x9d\x9b\x18\xca\xae\xa7\x09\xdf\x1b\x8e\xa8\x92\x9a\xb0\xd9\x13\x0c\xbf\x30\xa5\xf3\x61\x02\x5b\x84\xef\xf1\xde\x21\x0e\x26\xc7\x2b\xa7\xbf\x9d\x11\x2a\xc6\x63\x31\x81\x72\x26\x14\x53\x2f\x47\xa6\x7d\x64\x0a\x34\x0c\x57\xb9\x6e\x46\xd4\x84\x36\x74\xaf\xf8\x40\xff\x6a\xfa\xdd\xe7\x1f\x48\x1e\x87\x19\x0f\x5f\x80\xa4\x03\x57\xa9\x67\x08\xa9\x83\x00\xa1\x7b\x1e\x49\xc3\xcb\xbf\xfd\x58\x4b\xc5\xfb\x37\x18\x4a\x99\x1c\xbd\x7f\x0c\x15\xc6\x2a\xd8\xb4\x90\x2d\xc4\x98\x1e\x8b\xd3\x73\x8d\x79\x57\x24\x2a\xe4\xa6\x5e\x16\x72\x05\xce\xf0\x4b\x06\xed\x72\x0d\x91\x5c\xd8\x59\xe0\x6d\x83\xd6\x36\xa3\x3f\x49\x4c\xd6\xa0\x36\x48\x20\x83\x76\xc5\x70\xe0\x39\xa9\x0d\xd5\x3b\x2c\xe7\xd8\x56\x6a\x8a\x8d\xcb\x05\xc4\xfb\x15\x1a\x59\x58\x6e\x58\xc7\x66\x55\x20\x62\x77\xe2\x58\xde\x49\x72\x6d\x7a\xfd\x8e\x6e\x05\x8c\xea\xb7\x9d\x2e\x4d\x4b\xd6\x50\x66\x1c\xfd\xb2\x15\xdc\xe3\x2c\xa5\xfe\x52\x85\x9f\x9d\xa7\xbe\x37\x5d\xf8\x69\xb1\xce\x87\xa5\xa6\xa9\x99\x8b\x69\x63\x24\xd7\x8c\xff\xb7\x00\x6a\x33\x95\xf2\x6c\x50\x5b\xf3\xbb\xa5\x1f\x87\x57\x94\x65\xda\xde\x84\xcf\xe1\x15\xe9\xbf\xf3\xd9\x79\x11\x02\x94\x9b\xb6\x6e\x33\xbb\xe5\x78\x34\x9c\xce\x7c\x76\x73\x8c\x54\x84\x99\xc3\x73\x5f\x01\xad\xec\x8c\x5b\x1d\x86\x61\xd5\x2d\x2c\x67\x7e\x90\x5f\x38\x7c\x70\x01\x8e\x4e\xb5\x3d\x86\x06\xac\xd9\x97\x87\xfd\x83\x76\x46\x98\x1c\x78\x96\xdc\xd0\xb8\xd1\xce\xa8\xb5\x31\xa4\x91\x5c\x63\xb0\x59\x66\x1a\x58\xb7\xc1\x0d\xef\xc1\xcd\x2a\x5c\x96\x82\x87\x5e\xd5\x74\xd3\x83\x79\x05\x22\xf5\xcd\x02\xb5\x22\xb1\x05\xb8\xfe\xdd\xea\x52\x18\x85\x6a\x97\xd1\xb5\x0d\x82\x1c\x97\xe7\x88\x7e\x06\x53\x3c\xdf\xbe\x64\xd0\x28\x4e\xdf\xad\x86\xb4\x5b\x5e\xb4\xc3\xaf\x5c\xac\x22\x75\x23\x28\xb7\x4e\xbc\x92\x55\xdd\x1a\xd9\xd2\x48\x17\xb2\x0d\x7d\x40\xba\xdf\xa8\xf8\xdd\xc6\x74\x05\x3c\x7d\x5f\xf4\x15\x94\xf2\x27\xf8\x60\xce\x41\x75\x55\xa7\x61\x33\xc4\xc5\x21\x69\x5c\xc4\xcb\xef\x0d\xc2\xae\x18\xf4\x3f\x42\xca\xb5\xbc\x4d\x21\x2e\xea\x9b\x05\x6a\x9f\x69\xa4\x6a\x80\xb8\xcf\xbf\x6f\xb9\xb1\xaa\xda\x6a\x1b\x99\x55\xd5\x63\xef\xd3\xc2\x55\x29\xac\xcb\x72\xc5\x2b\xfc\xb1\xb2\x3c\xe7\x22\x19\x63\xf4\xaf\xa6\x82\xea\xb1\x88\x34\x54\x03\x16\x99\x03\xb3\xb0\x60\x74\x5b\x89\xbd\x23\x2c\x50\x9e\x01\x7d\x8c\xec\xa9\x88\x9f\xbd\x8d\x48\x75\x91\x06\xd6\x22\x3d\x6a\xa3\x39\x96\x50\x7c\xe8\x83\x7e\xc8\x7c\x38\xe8\x52\xf7\xb2\xba\xf7\x25\xb1\x85\xe5\x34\x11\xa6\x42\xbb\x50\x80\x8e\xb2\xc9\xc2\x71\x26\x96\x4d\xe3\xb8\x98\xbd\x7e\xd4\x7e\x57\x64\x18\xf4\xb3\xb6\xec\xe3\xe6\xbe\xa7\x50\x95\x22\xab\xf0\xf4\xe5\x81\x2e\xf9\x87\xfd\x91\x7f\x9a\x22\x04\x80\x7b\x2b\x71\x53\x9b\x8f\xde\x1d\xbf\x96\xc1\xae\x9c\x0b\x4f\xa1\x5e\xc4\x30\xbf\x22\xf9\x72\x80\x5a\xfa\x77\x92\x0a\xb7\xbb\xf4\x9c\x12\xb1\xcb\x54\xd5\x9f\x8e\x28\x57\x73\x32\xc1\xc2\xf5\xb8\x0c\xe2\x2e\xd4\x0e\xf1\x98\xbb\x0a\x83\xfa\xe4\xd5\x73\xe6\x38\x77\xa1\xf0\x5d\xc2\x5c\xc9\xf0\x56\xbc\x0c\xe3\x39\xbd\xd7\x06\x9b\x80\x53\x96\x2c\xd9\x37\xe6\xb1\x6b\x77\xb5\x9c\x83\x99\xbc\xae\x90\xf7\x84\xc4\xf6\x8e\x4a\xc8\x91\x8b\x6a\x39\x15\x4c\xb3\xec\x3e\x17\x9e\xf0\xea\xaa\x4c\x54\x57\x24\x88\xba\x02\xeb\xf3\xcd\xce\x56\xe1\xff\xbd\xab\xb4\x93\x52\x34\xa3\x01\xbb\x68\xc5\xea\x49\x62\xf1\xce\xda\x91\xdb\xdd\x0c\xab\x6f\x19\xf5\xd0\xe2\x0b\xb6\x09\x8d\x0b\x63\x58\x33\xc2\x64\x39\x3e\x9d\x0a\x9b\xf4\xc2\xcc\xa5\x7d\x43\x35\xbe\xdd\xa3\xaa\xc1\x6e\xf8\x1c\x95\xa8\xca\x93\x88\xdb\xea\x1f\x3e\x1e\x9f\x72\x12\xbe\xc6\x84\x35\x71\xeb\xeb\x86\xb2\xec\xf3\x0c\xa2\x6f\x07\x9c\x76\x12\x7e\x87\xec\xd5\x95\xc5\x3c\x91\x39\x66\x54\x4a\x54\x86\x18\x23\x69\x85\x54\x4e\x77\xd4\xe3\x60\x66\xe0\xb4\xe2\x3a\x90\x16\xfe\x5d\xef\xb2\x90\x27\xcb\x09\x30\x4b\x82\xc6\xf1\x35\xf0\xd8\xf6\x6c\x9a\xb6\x9f\x84\xb2\x7d\x1c\x11\x2c\x3b\xcf\x2f\xa3\x30\xa8\xba\x40\xde\x30\x9c\xa7\xe3\x15\x0f\x55\xf7\xf9\xff\xed\x1f\x54\x12\x9c\x28\x0f\x11\xa3\xdb\x7a\x6b\x19\x38\x59\xe3\x51\x4d\x1b\xbb\xbd\x1c\xc0\x32\x5e\xe9\xe9\x7c\x7e\xe2\xd8\x20\x6f\xd3\x64\x89\xd3\x7e\xce\x2d\x5d\x19\x64\x96\x3c\xf7\x10\xc6\x08\x9b\xa6\x50\x4c\xb3\xc3\xa7\x07\x5f\x3e\xae\x82\xf1\xdc\x66\x7d\x25\x2f\xd4\xad\xdd\x6e\xae\x2c\x82\xd8\x22\x91\xcf\xb2\x30\x28\x95\x07\xc8\x19\x06\x72\x4b\x5d\x52\x6e\x99\xdd\xce\x24\x23\xfa\xd5\x42\x17\x94\xf3\xae\xdb\xa3\x42\xcc\xe9\x1f\xa4\x3f\x0d\x16\x14\x50\x78\xaa\xa0\xd1\xb3\xc2\x5d\xa2\x5d\xae\xad\x82\xb6\xe2\x64\x7a\x11\x9c\xd8\xe8\xe3\x2b\x41\xaa\x2a\x40\xeb\xd6\x78\xea\x56\x9d\x5a\xc5\xd1\xb7\x01\x2d\x6f\x5e\x87\x77\xee\x3a\x93\xb2\x0a\x2e\xb3\xb7\x29\x5f\xb8\x56\x31\xe5\xe9\xfe\xe3\xd4\x0f\x31\x57\x22\x6a\x20\x04\x2e\xb9\x78\xf0\x23\x4b\x73\xda\xd5\xf3\xcc\x3f\x1e\x18\xba\xaa\xc4\xa3\x67\x2d\x61\x1b\xcf\x23\x5a\x76\x7a\xf5\xe3\x81\xd5\x2d\x4d\x18\xfb\x2d\x89\xa9\x1a\xa2\x6c\x7a\x47\xfd\x28\x5b\x0c\x17\x34\xb8\xb1\x03\x81\xa2\x69\x3d\x5b\x80\x46\x5c\x24\xd1\x9c\x27\xb4\xcc\xc5\x1a\xe3\x4a\xdf\xf2\x92\x96\xbf\x59\xe1\xb2\xf4\xda\x5d\x97\x20\x02\x3d\xb2\xb4\xc8\xb1\xdb\x6b\x36\xf9\xdb\x30\x65\x19\xfe\x50\xd6\x99\xab\x72\x41\x63\xdc\x2b\xe3\xfb\x87\x78\xe1\x9c\x4c\x19\xc2\xd1\x58\xa2\x8a\xa4\x76\x3c\x05\x4c\xa9\x7d\x86\x98\x45\x45\x99\x5b\xbf\xc5\xd6\x2c\xdc\x16\x31\xfe\x5b\x70\x0c\xc7\x31\x10\x29\x37\x8f\x5e\x67\xe7\xe4\xfb\x2e\x4e\x12\x3f\x5a\x2b\x35\x65\x35\x75\x4b\xba\x16\xfb\xda\xe7\x09\x71\xd6\xb3\x7d\x73\xd6\xd4\x14\xce\xd5\x30\xa8\x80\x7b\x0e\xe6\xc8\x3d\xe2\x2a\x98\x9a\x9e\x68\x21\x11\xeb\x1c\x7b\x6a\x80\x85\xd8\x16\x46\xd5\x14\xf9\x7f\xe9\xfd\x5f\x22\xbd\xff\xb7\xe5\xd6\xf8\x7d\xf1\xdc\x16\x64\x4d\x65\xe5\x37\x3f\x62\x44\x44\xa8\xcd\x1e\x35\x0a\x3c\x85\xc5\xc2\x3b\x97\xc2\x57\xd7\x49\x59\xb8\x06\xb8\xd6\xe4\xaa\x71\xcd\xb2\x34\xbc\xcc\x33\xc1\xa0\x2a\x39\x1c\x48\x11\xd3\x44\x06\x07\x56\x4e\xba\x87\xf6\x8f\x57\x81\xd9\x5c\x34\x08\xb6\x20\xe4\x39\x44\xbb\x52\x6c\x5a\xe1\x7e\x8b\xe4\xd3\x57\x10\xc9\x93\x37\xc3\x24\xb9\x09\xe9\x14\x1c\x90\x1b\xac\x54\x61\x85\x8d\x8a\x13\x37\x05\xc0\xbf\xe2\x99\x4d\xcc\xd7\x18\x38\xac\xa8\x87\x88\x8a\xb8\x82\x21\x75\x0e\xb8\xbc\x8a\x58\x48\x38\xfe\x7a\xf1\x2f\x1c\xce\x71\x8d\xb1\x2b\x9a\xca\x9b\x8b\x8d\xfe\xcc\xa6\xd2\xc5\x6a\x2f\x79\x53\xac\x94\xe6\xcf\x35\x45\x6a\x1c\x05\x9c\x7a\xe1\x0c\x18\xe2\xf1\x2f\xc9\x65\x59\xe4\x87\x1f\x8a\x4a\xe0\x3a\xaf\xb0\xb6\x64\xb8\x36\x6c\xa2\x20\xd5\x72\x71\xb6\xf8\x01\xd6\xf1\xf5\xf0\xef\xa3\xd5\x4a\x72\xa7\x87\x14\xeb\xd1\x2c\xad\x16\x97\xe7\x16\xfa\x0b\xf9\x41\x83\x79\x62\xa1\xed\xd6\x32\x5b\xcd\x86\x3f\x3c\x30\x5c\x9f\x4a\x05\xb4\xf7\x5b\xb8\x7a\x1b\x72\x52\x6c\x3f\xc3\xfb\x1c\xa3\x9f\x61\xec\x18\xaf\x93\x83\x4f\xc7\x40\xb3\x04\x59\xe7\x27\xbb\xe0\xdf\xfe\x7d\xeb\xa7\x84\x87\xe2\xc9\x6b\x92\xd2\x3f\xf2\x30\xa5\x7b\x1d\xfe\xa1\xf3\x7d\xa5\x33\x02\xfb\x77\x06\x28\xfc\xec\xb1\xf9\x4d\x0d\x70\x10\x25\xf9\xbc\xa8\x91\x86\x7e\x31\xbd\x43\x0c\xfd\x21\x36\xbc\x55\x0d\x7b\xee\xde\x7f\xe4\x34\x5d\x33\x1e\xb0\xd7\x87\xd4\x3e\x3b\x86\x75\x21\x12\xf9\x45\xc0\xf1\x60\xb7\xe2\x7f\x15\x31\xb4\x01\xe9\x68\x12\xd4\xb1\x61\x37\x8d\x63\xc9\x2a\xb3\x3e\x8d\x6f\xfb\xa7\x67\xc7\xa3\x2f\xb3\x93\xe9\x97\xc9\xe8\x97\xd1\x70\xf6\xe5\xc3\xe9\xd1\x87\xd9\xbb\xb3\xc9\xf8\xb7\xd1\x31\x50\xd2\x39\x68\x5e\x1b\x7a\xbf\x42\x95\xac\x44\x13\x7a\x5d\x49\x81\xdf\xa3\xb7\x34\xce\xba\x24\x48\xc0\x33\xbd\xcf\xbe\x77\x4f\x0c\x5a\x19\x48\x67\x3f\x4a\xae\xf7\x3a\x78\x2b\x6c\x34\x9d\x91\xc9\x68\x38\x1a\x7f\x1c\x1d\xc3\x64\xc9\x0f\xe4\x97\xe9\xd9\x69\x5f\xf0\x32\xbc\x5a\x0b\xb4\xdf\x37\x33\x95\x63\x37\xd6\xb6\x3f\xe7\xd1\xcb\x4b\xca\xb9\xc9\xf6\x04\xcb\xbb\x1a\xc5\x69\xda\x25\x73\x3f\xf3\x6b\x88\xc5\x7f\xa0\x3b\x10\x6e\x0b\x84\x3d\x2d\x84\xae\x50\xab\xfe\xd8\x88\xf0\xc6\x56\x64\x28\x1e\x73\x9f\x2d\x2e\x13\x3f\x9d\xd7\x62\x52\x90\x2b\x9f\xb1\xbb\xa4\x05\xa0\x4c\xde\xc0\x92\xe1\x94\xfb\x82\x29\x9f\x0e\x2e\xfa\xb2\x2e\xbc\xc5\x48\xea\xe6\x79\x15\x47\x79\x2b\xbd\xd5\x42\xa9\x7f\xb0\x56\x7b\x88\x3a\x7c\x7d\xf0\x93\x22\xb0\x1f\xf1\x9b\x7e\xff\x08\x7f\x22\xe1\x0f\x3f\x34\x30\x1e\xff\xe1\x12\xc9\xbe\x9f\x42\x35\x9d\x5f\xe9\x9a\xbc\x06\x99\x3e\x56\x8c\xec\xb4\xc0\x84\xff\x0a\xce\xc3\x24\x2b\x58\xf9\x31\xbe\x95\x51\xf8\x6f\xb3\x0d\xa0\xd2\xd8\xc4\x1f\xce\x9b\x92\xf7\x3b\xb1\xa7\xec\x8e\x73\x29\x16\x4b\x31\xe9\x5c\xca\x50\x5b\x1e\x29\x99\x03\x16\xd5\x60\xfe\xd6\x8c\xe2\x02\xbe\x12\x45\xd3\x35\x3a\x55\xff\xb7\x00\x83\x70\x50\x2e\x75\xb7\x09\x1e\x95\xde\x80\xfc\xf5\xaf\xaf\x9a\x21\xfd\x6c\x01\x7a\x6c\x1f\x1c\x08\xb6\x8f\xea\x8c\xeb\xaf\x3e\xfc\x84\xbf\x3b\xfb\xc5\xfd\x7d\xad\x4d\x55\x77\x63\x7b\x9a\xc7\x9d\xc6\x31\x80\xc3\x8b\x64\x0e\xa3\x9c\x9f\x4d\x67\xcd\xe0\x0b\xea\xcf\x61\x7d\x06\xed\xd6\xb6\x73\x14\x04\x74\x95\x75\x00\x3d\x10\x1d\xa1\x93\x06\x5c\xdd\xff\x9d\x25\x2d\x28\xe3\x08\xb0\xf2\x0b\xe6\xd5\x43\x4b\xca\x46\x73\xdf\xbb\xbb\xbb\xeb\xa1\x8a\xee\xe5\x29\x48\x32\x5e\x1b\x9f\xb7\xc4\xfb\x81\xd1\xb4\x77\x74\x0d\xa8\x11\x2b\x98\x8e\xd1\x7e\xe5\x40\xb4\x3b\x6d\x1a\x51\xfb\x39\x5f\x30\x71\x5b\x6b\x80\xab\xa2\xa4\x7b\xab\x70\x3e\x4a\xcd\xa1\x74\x82\xad\x00\x92\xc9\xcd\x98\x3e\xda\x0d\x94\x65\x7b\x52\x62\xb5\x23\x09\xac\xd7\x16\x7b\x10\xf1\x5d\x26\xf3\x35\x1e\xda\xd5\x33\xdb\x86\x36\xce\x5c\xbc\xb4\x97\xb3\x41\xa7\x8b\x17\x1f\xfa\x8c\xff\x42\x03\xaf\xfe\xc8\x72\xa2\x79\x27\x65\x0a\xf0\x58\xe7\x35\xa2\x95\x12\xe7\x38\xb5\x6d\xa4\x9c\x08\x9a\x8d\x50\x10\x00\xc1\x5e\x27\xcf\xae\xfe\xee\x30\xa1\x5c\xfd\x80\x5d\x1d\x3c\x86\x3a\x1a\xff\x82\x45\x1e\xdf\x00\x07\x05\x7b\x7e\x78\x4d\xf8\x07\xb2\x69\x8f\x11\x5c\x0b\x1d\x61\x4b\x8d\xa8\x33\x07\x87\x6e\x1e\x4f\xf6\x42\x5b\xa9\x3f\x4f\x62\xea\x30\x38\x6d\xf0\x86\x59\x38\x9a\xb7\x81\x83\x10\x8a\x09\xa7\x69\x92\x76\x4c\xb3\x28\x69\x32\x78\xec\x39\x77\x46\xd8\x09\xc5\x41\xf4\x6e\x23\x4d\x7c\xea\x57\x7e\x18\xed\xb5\xe8\xf3\xc8\xc9\x71\x7b\x05\x94\xfc\x31\xc8\x07\xec\x12\xcd\x3e\xd7\x44\xb5\x61\x86\xa0\x13\x96\x4b\xb0\x73\x3b\xe0\xab\x0a\x4d\x2d\x3f\x3c\xf3\x22\xdc\x81\x0f\x4c\xf7\x14\xb5\xdb\xd9\x80\xf0\x20\xa1\x5b\x84\xc5\x79\xa6\x3a\xa8\x02\x0d\xa6\x7d\x30\x6f\x89\x3a\xd2\xa9\x56\xd1\x84\x8c\x95\x55\xbc\x6a\x9e\x5e\x5c\xc0\x69\x29\x9d\xdc\x49\x6e\xdc\x34\xb7\x72\xae\xc8\x54\x36\x18\x70\xa0\x46\xcf\x5a\xf7\xa8\x4f\x92\xf8\x5a\xb9\xd1\x2c\x58\xd0\x79\x6e\x5e\x56\x9f\xca\x6f\xa3\xfb\x15\x16\x15\xc8\x54\xad\xca\x7d\x62\x8b\x55\x49\x22\xb2\x66\x95\x98\x17\x77\xa1\xdd\xee\xb6\x1e\x3e\xa8\x2b\xc5\xe7\xa1\x52\x9b\x60\x99\xa0\xb3\xb2\x79\x2b\x71\xb9\xf5\xe1\x33\xbe\xa9\xf5\x19\xfe\xfc\xec\x99\xe1\x82\xcf\x30\xca\x67\xe5\xce\x95\x00\x32\xf6\x55\x00\x48\x11\x2d\x01\x86\xe2\x03\x07\xd8\x68\x69\x37\x47\x88\xc5\xb1\x7e\x22\x00\x72\x4e\xd3\x65\xc8\x98\x2b\x52\x42\xec\x50\x89\x06\xeb\x5a\x52\x62\xac\xa9\xbc\x1e\x4e\x8a\xeb\xc7\x83\x31\x1c\xc3\x37\xd4\x75\x45\xdb\x88\xac\x90\x1d\x17\x45\x7b\x0b\x00\x07\xe5\xdb\x9a\x59\xb7\xff\x75\x39\xe2\xa1\x24\x8e\xa6\xb6\x98\xac\x22\xee\xda\xc0\x8f\x0f\x63\x39\x9f\x25\x13\x57\xdc\xc4\xc5\xf1\x77\x3e\x9b\x48\x18\x7d\x31\x90\xb2\xb3\xd4\x08\x6a\x6f\x7b\xb6\xcc\xbc\x89\x0e\x14\xe7\xac\x47\x7d\x96\xf5\x0e\x81\x6e\x3d\xa3\xfe\x48\x1c\x77\x60\xd6\xf4\x5e\x3e\x01\x07\xcd\x05\x0e\x4e\x87\x44\x81\xa2\x5a\x7b\x8d\xce\x75\x71\xba\xe4\x17\xb6\x38\x1e\x3f\x72\x5c\xe2\x99\x68\x60\x72\x33\x18\x75\x1e\x55\xee\x4b\x28\xe7\x4d\x9f\xba\xab\x85\x4f\xbd\xe1\xa3\x5d\xb5\xa9\xab\xb9\x7a\xa1\x24\xae\x99\x65\xd6\xed\x4e\x35\x33\x91\xe1\x79\x14\x0f\x2a\x95\x87\x9c\x2c\x70\x25\xf0\xba\x10\xe0\xd3\x6e\x50\x6e\x5b\x96\x47\x8f\x66\xb3\xcf\x81\xbc\xab\x3f\x7f\xa5\xd7\x78\xd6\xf2\xc7\xba\x5e\x6f\xdc\x10\x72\x5f\xfb\xb4\x5f\x4c\xa9\x59\xfe\x56\x2f\xa5\xd4\x3e\x80\x62\x3d\x41\x51\xbe\x46\x62\x7c\x27\x95\xb7\x49\x8c\x66\x2b\xdb\xd2\xf0\x7c\x8a\xf9\x74\x8a\x3d\x8e\xf6\x90\x8a\xd5\x84\x91\xc2\xc0\xd6\xab\x16\x8c\x99\x8d\x31\x9e\xa8\x78\xe1\xa0\xb6\xe6\x5d\x12\xa5\x61\xcb\xb3\xa6\xf2\xa0\x8a\x3b\xe5\x67\x1c\xf3\x66\x8a\x45\x5f\xef\x6e\x15\xac\xfe\x7d\x9b\xe7\x7f\xba\xa6\x76\x91\x79\x2b\x15\x45\x6b\xf8\x7a\xdd\x65\x51\xb4\x76\x2c\xa3\x9e\x7f\x71\x26\xe4\xea\xfa\x08\x9d\x40\x53\x95\x79\x63\x58\x7c\xe8\x2c\x7f\x6b\xc6\x36\xb1\x71\xfd\x33\xcc\x16\x2d\x70\x05\x2f\x1b\x89\x07\x90\x23\x70\xd8\x93\x34\xfc\x93\x3a\xcb\x47\x2b\xbd\x5c\xe9\x45\xed\x19\x1a\x27\x5f\xff\xe2\x40\x63\x7d\xd9\xf2\xbe\x4f\xc5\x8c\xbe\x68\x56\xc9\xfa\x2d\x5d\xb4\x0d\xac\x3c\xb3\xf1\x20\x45\xbf\x48\xaf\x95\xb7\x4e\xa5\x52\xd2\x6d\x64\xb3\x00\xd1\x1b\x2f\xfd\xeb\xa2\x8d\xff\x28\x1b\x1f\x1e\x78\x8a\x4c\x58\x8a\x47\x69\xea\xaf\xcb\xfa\x76\xf9\xd5\xdc\x3b\xc5\x6d\x43\x9e\xa8\xea\x92\xef\x68\xc4\xe5\x9e\x97\x3b\x3b\xf1\xa8\x8e\x78\xd1\x97\x77\xda\x6c\xba\x0f\x0f\x18\x0c\xdf\x6c\xe0\x7f\xe3\x79\xf5\xbe\xaf\x42\xba\xd1\xcc\x56\x13\xf4\x42\x91\xcf\x83\xea\x80\x3a\xa6\xc5\xf0\xe2\x56\x05\x78\x5f\x66\x35\xa0\x9a\x0c\x4c\xe1\x16\x8f\x11\x1b\x7e\x53\x14\x89\x97\x03\x79\xf2\x09\x0c\x47\xc9\x9c\xf6\x46\x46\xf9\xda\x5a\xf1\x5e\x45\x7d\x07\x09\xa3\x3d\x19\xe2\x7a\x9c\xb3\xe4\xf3\x0d\x5d\x03\x97\x05\xc5\xe2\xd5\x92\xdb\xf7\xfe\xca\xa8\x74\xc4\xb2\x73\x00\x93\x45\xae\x6a\x7a\xb2\xcf\x46\x7f\x21\xc7\x48\x57\xd7\x8f\x71\x12\xc6\x37\x1f\xfd\x94\x6d\x1d\xa5\x11\xbf\xf7\xeb\xf8\x74\x34\x1d\x4f\x3d\xf7\x23\x11\x85\x92\x3e\x39\xfb\xf9\xcb\xcf\x93\xb3\x0f\xe7\x5e\xcd\xb3\x10\xa5\x3a\x9f\x9c\x0d\x47\xd3\xa9\x2d\xe7\x86\x1f\xf3\x31\x89\x40\x47\xeb\x1a\xbf\x74\x5a\xdf\xa3\x4d\x82\x55\x14\x12\xc8\x9e\x5f\x5f\xf7\x0d\x2b\xec\x22\x45\xfa\xda\x95\xda\x97\x12\xa9\x8e\x10\x83\x80\x76\x3d\xf5\xf2\x13\x93\xfc\xca\xf3\x0e\xcd\x35\x2b\xee\x1a\x50\xdd\xa6\xd8\x76\x97\xba\x5d\x91\x50\xf5\xb6\x6f\x79\x7c\xeb\xa6\xeb\x8e\x9c\xc4\xaa\xe2\x30\xa2\xd7\xb4\xf0\xa5\xcb\x2f\x72\x30\xe1\x58\xd5\xa2\x03\xfd\x5b\xe7\x6d\xe9\x0f\x25\x55\x9f\x23\x32\x6d\xbc\xe9\xab\xc1\x40\x3e\xfa\x25\x49\x3b\x06\x05\x85\xc7\x73\x51\xad\x01\x14\x20\x17\x1a\x8c\xc0\x00\x1d\x76\x64\x58\x2a\x6a\xff\xed\xf2\x6a\x6f\xe6\x5f\x33\xdb\x93\x13\x0f\x40\x78\x6c\x0d\x07\xeb\x12\x6d\xda\xa2\xac\x46\xbe\x33\x46\x4c\x8f\x4b\xc2\xe3\x93\x90\xdd\x5a\xfb\x5c\x77\x1b\x5c\xa7\x95\xc6\xb5\xff\x0a\x00\x00\xff\xff\x0b\xb2\x62\x79\xf5\x5b\x00\x00")
+var _templatesAppTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7c\x7b\x6f\xdb\xb8\x96\xf8\xdf\x3f\x7f\x0a\x82\x28\xe0\x74\x46\x71\x93\xf6\xfe\xee\xde\xab\x6e\x2e\x90\x71\xdc\xa9\x67\xf2\xf0\xda\x69\x07\x3b\x69\x30\x50\x24\xc6\xd6\x44\x26\x55\x8a\x4a\xe2\x11\xf4\xdd\x17\x47\x22\x25\x52\xa2\x6c\xc7\x49\x8b\x5d\xec\x26\x46\xeb\x90\x87\x87\xe7\x45\xf2\x9c\xc3\x47\x96\xa1\x80\xdc\x86\x94\x20\xec\xc5\x31\x46\x79\xde\x43\x28\xeb\x21\x84\x10\x3e\xfe\x6d\x76\x49\x96\x71\xe4\x09\xf2\x81\xf1\xa5\x27\x3e\x13\x9e\x84\x8c\x62\xe4\x22\xfc\xf6\xe0\xf0\x60\xff\xe0\x9f\xfb\x07\xff\xc4\x4e\x09\x3e\x64\x34\x08\x45\xc8\x68\x82\x5d\x89\x02\xa1\x2c\x43\x42\xe2\x40\xf8\xc6\x8b\x3c\xea\x13\xbe\xef\xd7\xa0\x68\x50\xf6\xd9\x02\x8e\x39\xf3\x49\x92\x6c\x05\xcb\xc9\x3c\x4c\x04\x5f\x75\x01\xe3\x09\x0f\xef\x3d\x41\x80\x30\x84\x3f\x50\xd7\x1d\x7d\x4d\xbd\x08\x08\xbd\x82\x92\x29\xb9\xc5\x6e\x0d\x85\x72\x07\xe1\xff\x24\x09\x46\xd7\x28\x77\x14\x8e\x9f\x22\x8f\xde\xcd\x88\x9f\xf2\x50\xac\x7e\xe6\x2c\x8d\x41\x10\x99\x8e\x0e\xb9\xe8\x2a\x2b\xb0\x81\x88\x4c\x58\xc0\x89\xaf\x4b\x8a\x24\x52\x3c\xf1\xb8\xb7\x24\x82\xf0\xa2\xe9\x7a\x99\xc5\x00\xbb\x85\xbc\x0c\x38\x09\x88\x87\x51\x9a\x08\xc2\x35\xc5\x20\x84\x2f\x57\x31\x81\x7e\xf1\x4c\xf0\x90\xce\xa5\x1e\xe1\x83\x4f\xc8\xad\x97\x46\xa2\xa8\x35\xcb\x13\x9f\x87\xb1\x50\x56\x80\x65\x55\x2d\xa5\x13\x12\x47\x6c\xb5\x24\x54\x9c\x79\x8f\xe1\x32\x5d\x5a\xfa\x74\x11\x3e\x4f\x97\x37\x84\xdb\xba\x2c\x6c\xeb\xa0\xab\x53\x17\x61\x89\x17\xc5\x84\xfb\x84\x0a\x6f\x4e\x10\xbb\x45\x92\x7d\x92\x20\xc1\xd0\x1d\x21\x31\xe2\x29\xa5\x21\x9d\xa3\x87\x45\x18\x11\x14\x14\x74\x01\x9b\xeb\x48\x0e\xe9\x8e\x24\x1f\xae\x27\x39\xa4\x2f\x4b\xf2\x88\xde\x87\x9c\x51\x10\xb3\x9d\xd8\x6e\x95\xae\xd1\xa8\x55\xa1\xbf\x92\xd5\xb7\xee\x42\x1b\x9d\xdb\x75\x63\xe0\x1b\x72\x02\x03\x20\xa4\x82\x70\xea\x45\x28\x62\x5e\x80\xd4\xb0\x49\x50\x48\x51\x5c\xe2\x47\x49\x7a\x43\x89\x48\x3a\x48\x3e\x67\x7a\xc5\x71\x14\xb1\x07\x12\x7c\xf6\xa2\x94\x94\xf3\x44\x31\x23\x38\x08\x9f\x33\x8c\xae\x5b\x3c\x4c\x49\x44\xbc\x84\x7c\x87\x11\x36\x25\x31\x4b\x42\xc1\xf8\xea\xc5\x3b\x9b\xb1\x94\xfb\x04\xf9\x2c\x20\x88\xd7\xdd\xb4\x48\x30\x67\xb6\x97\xa6\xe2\x72\x41\xd0\xa9\xae\x44\x94\xc8\xfe\xd0\x1c\xa6\x5d\x74\xcb\x38\x12\x8b\x30\x41\xb0\x64\xb5\x89\x93\x5a\xb6\x93\x75\x1a\x26\xe2\xdf\x8f\x7f\x9b\xb9\xee\x68\xf8\xd6\x75\x4b\x60\xd7\x1d\x07\xff\xda\x85\xd4\xcf\x93\xa1\xb2\xaa\xed\xa8\xea\x36\xf5\x6f\x43\x5c\xc3\xf4\x37\x10\xa9\x16\x78\x83\x3a\x1d\xad\x8b\xf0\xde\x74\xf4\x1f\x9f\xc6\xd3\xd1\xc9\x6b\x74\xea\x2d\x6f\x02\x0f\x0d\xd3\x44\xb0\xe5\x25\x8b\x43\x1f\x7d\xf4\x68\x10\x11\x8e\xe4\x70\x40\x0a\xa3\x46\xe6\x59\x48\x4f\x09\x9d\x8b\x45\xb1\x82\x1c\x62\xa7\x73\xcc\xb7\xe9\x9b\x0c\x3b\x24\x57\x0b\xed\xf3\x64\x08\xea\xdc\x55\x60\x1b\x04\x34\x19\x0e\xc7\x27\xd3\x17\x37\x79\xe8\x19\x10\xdb\xbb\x37\x7c\x86\x33\x2f\x8e\x43\x3a\xd7\xed\x1b\x4f\x18\x17\x13\xce\x04\xf3\x59\x64\xd2\xb6\x10\xa2\x18\xa0\xa5\x6d\x11\x4a\xb8\x06\x87\x3f\x5e\x5e\x4e\xb0\x83\xf0\x98\x26\x02\xbc\x0c\x5b\x5d\x31\xd6\x49\x17\xc4\x0c\xd7\xd2\x91\xdd\x25\xeb\xfb\x9b\x3d\xbb\x43\xa3\x47\xe1\xaf\xe1\xef\x72\xd8\xc9\xde\xe5\x70\x43\x67\xb3\xd9\x69\xb3\xab\x68\x0d\x6b\x00\xfe\xbc\xae\x50\x6e\xd5\xf7\x94\x24\xc5\xac\x6c\x28\x5c\x1b\x72\x53\x16\xd9\xa6\x13\x35\x26\xc6\xc7\x67\xae\x5b\xc0\x68\x9c\x4c\x38\x8b\x09\x17\xa1\x81\x14\x3e\xf8\x38\x49\xd2\x25\x01\xf8\x09\x8b\x42\x7f\x75\xc2\xfc\xb4\xe5\x65\x34\xe6\x0a\x88\x05\xde\xee\x1f\x1e\xec\x1f\xfe\x9b\x66\xe3\xf0\xc1\x33\xe1\x09\x22\xdb\x5f\x19\x55\xa8\x81\x0f\x7e\xf1\xe8\xf6\x96\xf8\xc5\x62\x5c\x2c\xbf\x0d\x6c\xca\x57\xa0\x7e\x18\x7b\x20\xd9\x0c\x84\xca\xef\x43\x1f\xd8\xbd\x42\x38\x2a\xe6\xa3\x81\xb7\xf4\xfe\x62\xd4\x7b\x48\x06\x3e\x5b\x1a\x3e\x7c\xfd\x8b\x8f\x7d\x39\xa1\x5d\x21\x9c\x88\xc4\xad\x19\xaf\x57\x77\xf5\xa3\x14\x53\xfe\xea\xb5\x06\x66\x3c\xf1\xc4\x02\x88\x7f\x63\x10\x8e\x0b\x49\x96\xb2\xbe\xea\xad\x93\x40\x09\xb9\x3a\xf7\x96\xc0\x10\x3e\x0e\x96\x21\x85\xb8\xc6\x13\x4c\xf7\x3b\x0d\xe0\x4e\x05\x6d\xad\xa4\xb6\xa2\x50\x66\x51\x85\x26\x32\xfc\x03\x76\x6a\xc3\x2c\x0b\x50\xbe\x41\x6c\x79\xcf\x26\xc2\xbc\xd7\x10\xa4\x6e\xda\x6b\xcc\xba\x5c\x7a\x5c\xf7\x43\x4a\x4b\x45\xd6\x3c\xad\xb1\xee\x21\x0b\xcc\xb1\x02\xbf\x78\xf6\xee\xa7\xd4\xbf\x23\xa2\x8e\x0e\x7f\x61\xa1\x34\x8d\x7d\xec\xa0\x2b\x84\x7d\x46\xef\xd9\x23\x76\xb4\x60\xb1\x20\x63\x4a\xe6\xd0\x39\x30\xdf\xb6\x33\x3c\x7b\x27\x9d\xe7\x26\xd6\x12\x29\x2f\xd7\xc8\x37\x06\x5a\xa5\xb1\x22\x06\x7d\x73\x5b\xc4\xdd\x21\xa3\x83\xbf\xc2\x18\x4c\xf9\xda\x90\xaa\xd1\x21\x96\x6b\x2f\x76\xab\x91\x40\x1e\x4b\x9f\xd8\xd0\x38\x3e\x23\x4b\xc6\x57\xb3\xf0\x2f\x90\x05\x3e\x7c\xfb\x0f\xb3\x5a\x4d\x28\x25\xd1\x3f\x13\x71\x2c\x40\x34\x57\xed\x59\xc7\x41\xf8\x98\xd3\xd6\x08\xc3\xd3\x94\x8a\xb0\xb4\x61\xca\x02\xf2\xa7\xee\x71\xc3\xfc\x14\x2e\x09\x4b\x01\x27\x7e\x77\x80\xbb\x4d\xc1\x1e\x07\xf3\x6a\x3e\xec\x0c\x85\x7d\xce\xe8\x9f\xec\x66\x1b\x50\x19\x83\x3d\x05\x34\x29\xa7\x9c\x6d\xd2\x11\x4d\xac\x36\x60\xe5\xdb\xe2\x0e\x64\x09\x8c\xcc\xaa\x52\x0a\x07\x5f\xa4\x22\x4e\x0d\x27\xd7\x2e\x2d\x26\xe1\x36\x72\xb5\x11\xb0\x62\xca\x84\x94\xa0\x78\x46\x84\x68\xb8\x25\x30\xff\x40\xf8\x84\x5d\xcd\xbe\x2b\xb8\x5a\xdf\x3d\xf5\x6f\xde\xcb\x32\x44\x68\x50\xe0\xd5\x32\x53\xb6\x54\x50\x21\x8f\x2c\x43\xdc\xa3\x73\x82\x5e\xdd\x21\xf7\x08\x0d\x46\x54\xf0\x62\xfa\x4c\x14\x13\x65\xd6\x26\xcb\x50\x1a\xc7\x84\x03\x5c\x9e\xd7\x6b\x46\x86\xf4\xec\x8d\x1a\x9a\x58\xb3\x68\x3c\xa2\xde\x4d\x44\x02\x13\x43\xdd\xf4\x9c\x81\x1d\x5f\xb5\x11\x65\x8a\x61\xb3\xe5\x09\x49\x42\x4e\x82\x21\x4b\xa9\x00\xd7\x09\xed\x1f\x42\x67\xb2\xbf\x9a\xfd\xf5\x82\x50\x39\x9e\x86\x10\x48\x21\x84\x8a\xf5\xba\x67\x32\x00\xa1\xa0\x3c\x1f\xc6\xa9\xa6\xa0\xae\xd4\x46\xe5\xb6\xba\x08\xd7\x69\x0d\xc3\x69\x85\xb8\x7b\xf2\x09\xa5\x34\x14\x45\xc6\x85\x13\x18\x16\x04\x1b\x46\x6a\x21\xa0\x9c\x7b\x9e\x48\x43\x96\x01\x86\xb2\xa9\x2c\x46\x79\xde\x49\xd8\xd9\x4f\x90\x10\x9a\x1e\x9f\x3d\x85\x30\x43\x31\x4d\xf2\xd0\x1a\xfa\xcc\x20\xc6\x1a\xcc\xd2\x42\xbc\x40\x54\x58\xb8\x87\x22\xf4\xa0\x3e\x81\x12\xb1\x20\x2a\x11\x04\xe4\xc6\x91\xe7\x13\xe4\xd1\xc0\x4c\x08\x31\x8a\x56\x2c\xe5\xc8\x97\x79\xbc\xaa\xbb\xb3\x90\xaa\x31\xb6\x7f\x58\xf3\xb8\xad\x21\x55\x63\xb9\xb0\x19\xfc\x6b\x48\x49\x12\xd6\x63\xd8\x32\x7e\x15\x48\x39\x60\xa1\x2f\x7c\xca\xe6\x66\x22\xc0\xd2\xac\x82\x51\xed\xd6\xd3\xa5\x4d\x9c\x1d\x94\x29\xb3\x29\x96\x61\x59\xeb\xba\x33\xc1\x89\xb7\x94\xda\xb0\x3b\x02\x78\xb6\xf0\x78\xa5\x67\x29\xb3\x35\xbc\x18\x1d\x9d\xb2\x79\xe2\xba\x15\xd0\x36\xac\xd4\xcb\x85\x1a\xad\xaf\x96\x1e\x0d\x6f\x49\x22\xb4\xe1\x9a\x65\x28\xbc\x45\x83\x8f\x5e\x32\xa9\xb2\x82\x72\x1c\x77\x8d\x6f\xbb\x1d\x8f\x86\xb3\x4b\x2f\xb9\x3b\x81\xa9\x33\x94\x23\x42\xb1\x5e\x98\x67\x4c\x68\x90\x5c\x40\xf1\x95\xe1\x6b\x39\x95\x33\x5d\x38\x00\xd7\xca\xc4\x34\x11\x94\xe0\xae\xdb\xee\xc3\xe9\xd9\x5c\xce\xc3\xc1\xc1\x76\x7e\x99\xec\xf8\x92\xdd\x11\xba\xd1\xf5\xe8\x74\x3b\xa4\xdf\xdc\xf2\xb6\x4a\x1f\xae\xb6\xc5\x42\x93\x33\xe1\xf9\x77\x45\x0b\x98\x85\x41\x92\xb5\x0c\xa5\xa3\x65\x20\xd7\xf2\x7b\x15\x22\x55\xd6\x00\x6d\x24\x67\x2b\x70\xbd\xbc\xd1\xa4\xf2\x13\x25\x28\xfc\xdd\x00\x01\xad\xca\xa5\x45\x5f\x96\x09\x2c\x79\x7f\x08\x2f\xb9\x2b\xfd\x91\xb5\xbe\xb5\xdd\x5e\xa4\xf4\x4d\x43\xa9\x76\x73\xb0\x6b\x59\x04\x6b\x51\x39\x1d\xb6\x55\x15\x2b\xdb\x7e\x45\xc0\xba\x7f\x92\x8e\x49\x6d\xc4\xb2\xb1\x3e\x2e\x06\x0a\x4a\x85\x18\x45\x67\xd6\x4e\xf5\xa9\x4e\x95\x34\x82\x08\x1d\xd8\x30\xf1\xba\x7c\x83\xb1\x2b\x01\xfd\xb7\xb0\x72\x6d\x2b\xa7\x32\x17\x55\xd6\x00\x6d\xae\x69\x15\xfc\xa6\xf5\xef\x7b\x0e\xac\xf6\x6c\xb5\x8e\xcc\xf6\xd4\x83\xf2\x8e\xe8\x45\x22\x91\x52\x2f\x34\xde\x80\x6d\x6d\xfc\x4c\xca\xfd\x19\xa3\x7d\x7b\x77\xa8\x1b\x8b\xf7\xb8\x05\x16\xef\xd1\x86\x05\x12\xde\xca\xec\x2d\x99\x82\x7a\x0d\x18\x40\xb2\x4f\x25\x01\x9b\xc3\x08\xb5\x95\xe4\x36\x94\xf4\xa4\x81\x66\x51\x61\x59\x30\x18\x32\x2a\xbc\x90\x12\x6e\x57\xab\x7d\x5c\x22\x8d\x8e\x62\x16\x3e\x67\xa5\xab\xb0\x5d\x76\x40\x47\x59\x7f\xb7\x2f\xbf\x55\x04\xd4\x1d\x34\x54\xdc\xeb\x4b\xed\x2b\xd5\xd0\x58\x6b\xeb\x36\x76\xe9\x63\x85\xaa\x36\x59\x85\x67\x20\x17\x74\x29\x3f\x68\x9f\x65\x75\xdf\x50\xc4\xd9\x63\x63\x2f\xa7\x19\x4c\x58\x86\xc4\x53\xf1\x6b\x9b\xda\xa6\x54\x9f\x49\x7d\x99\xd6\xfc\x86\xe4\xcb\x0e\x3a\xe9\xdf\xc9\x2a\xec\x11\xd4\x4b\x5a\xc4\x2e\xac\xaa\xaf\x3b\xed\x2f\x34\x70\x99\x60\x66\x50\x52\xed\x2c\x38\xcf\xa1\x76\x08\x49\xb6\xdb\xd0\xef\xde\xcf\xda\x7d\x43\xe4\x65\x28\xfc\xc8\x12\xb1\x13\x69\xe0\xb2\xd0\x80\x3c\x6a\x9d\x4d\x3d\x1a\xb0\x65\x62\xe0\xff\xf6\x1c\xd8\x46\xd7\x96\x3c\x9c\xb3\xb5\xe4\x3d\x63\xaf\x7b\x17\x46\xb4\xfd\x8e\xa7\xb3\x22\xa2\xe4\x19\xbc\x14\x7b\x60\x8e\xda\x9c\x72\xca\x3d\x23\xf8\x2f\x4a\x5e\x90\xbb\xc7\xd5\x4e\xac\x7d\x23\x2d\xed\x34\x29\x9a\xd9\x80\x5d\x66\xc5\xf6\x4a\xd2\x90\x5d\x63\x44\xae\x0f\x33\x1a\x6d\x2b\x61\xe8\xf9\x85\xa6\x0b\x0d\x8a\x31\xbc\x99\xd2\x65\x39\x39\x9f\xc1\x5f\xb8\x4a\xa0\xe7\xce\xf7\x9e\xc6\xd7\x47\x54\x1d\xd8\x75\x03\x68\x67\x55\x9e\x45\x1c\xca\x5f\xc4\xf4\xd5\x57\x15\x24\x7c\x0b\x86\x35\x73\x1b\xe8\x8e\xb2\x6c\x63\xf2\xb1\x93\xe9\x37\x13\x4e\x3b\x19\xbf\xc5\xf6\xba\x4e\xca\x3c\x53\x38\x55\x94\x5a\x9f\x7b\x30\x7b\x72\x7a\xeb\xc3\x51\x5c\x80\xe9\xd3\x8d\x25\xbe\x43\x5b\xc4\x77\xfb\x8a\xd4\x56\x10\x80\x0d\x8a\xc6\x74\xce\x49\xd2\x8c\x6c\x36\x0d\x3f\x09\x65\xfc\x55\x25\xcb\x26\xe9\x4d\x14\xfa\x4d\x60\x90\x6d\x18\xf0\x31\x48\x1b\x1f\x0c\x8a\xdf\x37\x7a\x84\x5e\xe1\x20\x51\xa2\x59\x4f\xbb\x75\xcd\xb8\x3a\xf6\x81\x72\x1b\x1a\x65\x6c\xfa\x0f\x1e\xc7\xfa\x0e\x7f\xb1\xe2\x34\x41\x3e\x70\xb6\x04\xb6\x5f\x72\x48\x37\xe9\xc3\x97\xec\xa5\xbb\x30\x7a\x30\x3a\xb4\x09\x63\x73\xc0\xa7\x27\x5f\x3e\xc7\xfe\x38\x30\x88\xfd\x3c\x19\xb6\xb6\x8a\x9c\xce\xe1\xa6\x8f\x30\x73\x88\x44\x5e\x22\x42\xbf\x9e\x3c\x42\x3a\x77\xdd\xfa\x4f\x3d\xa7\xbe\x76\x68\x36\x44\x55\xb7\x32\xb2\x5f\x5b\xcc\x05\xe8\x7a\xd3\x18\x2d\xcd\x9c\x7c\x45\x83\x99\xbf\x20\x4b\x82\xb0\x3a\xe3\x28\x27\x29\xf5\x8b\xcb\x7a\xa9\x5b\x05\xad\x11\xd7\x3c\x17\x57\x2e\x9b\x63\x10\xf0\x55\x7d\xfc\xd2\xe9\x88\xd4\x1b\x47\xd7\x50\xbe\x01\x50\x57\x2e\x32\xe7\x04\xeb\xa8\xab\xda\xb9\x56\x5c\x4e\xaf\xdb\xbe\x60\x1a\xa5\xa4\x38\x01\x70\xc2\xbd\x10\x0e\xcf\x96\x67\x28\x4a\x2e\xa5\xf2\xb0\x8b\x04\x4f\x89\xa3\x6f\x3d\xff\xfd\x40\xc7\xac\xe1\xd1\x37\x32\x11\x1e\x07\x11\xa9\x1b\xbd\xfb\xfb\x41\xa3\x19\x67\x49\xf2\x3b\xa3\x44\x75\x51\x57\x7d\x24\x5e\x24\x16\xc3\x05\xf1\xef\x0c\xad\x56\x55\xab\xcb\x05\x27\xc9\x82\x45\x60\xf0\xf8\xad\xa9\xac\x31\x68\xfa\xbe\x38\xe5\xf2\xff\x8d\x8a\x4b\x8f\xcf\xed\x47\x15\xca\x44\x8f\x3c\x6d\x64\x19\xed\x1d\x83\xfc\x43\xc8\x13\x01\x23\x5e\x79\x67\xb6\xc3\x0c\x9a\xe0\xde\x19\xe5\x9f\xe8\xc2\xca\x4c\x05\xa3\x4b\x4b\x9d\x9b\xda\x71\x15\x30\xad\x56\x8d\xdb\x5d\x66\x33\x19\x55\xe9\x9c\xd8\xd6\x99\x72\x68\x56\x61\x4b\xd9\xff\x87\x90\x06\x63\x7a\xe6\xc5\x72\xf0\xe8\x47\xef\x9c\x67\xce\xb2\x15\xa2\x62\x69\x6d\x1d\x33\x6b\x6a\x45\x89\x55\x9b\xc5\xbe\xf5\x7a\x82\xac\x47\xdc\xbe\xbb\x68\x3a\xce\xd2\xa1\xeb\xf5\x14\xbf\x84\x70\xe4\x18\xb1\x74\x33\x9b\x9d\x6a\x29\x91\x71\xf0\xec\xae\xf4\x04\x8b\x31\xa7\x36\x06\xd6\xff\x59\xef\xff\x2c\xeb\xfd\xdf\x6d\xb7\xc6\xdf\xd7\x2f\xed\x41\x76\x1c\xb6\xfc\xee\x4b\x4c\x99\x74\xdf\x66\x8c\x1a\x67\x3e\x4b\x8f\xa5\x68\x5c\x59\x87\xd3\xd5\x48\x79\xb8\x06\xb8\x56\x65\x69\x78\x2c\x04\x0f\x6f\x52\x51\x0a\xa8\x4d\x8e\xbe\xdf\x67\xe2\xc5\x8e\x1d\x58\x05\xe9\x18\xfc\x1f\xdc\x6b\x82\xe4\xd7\x4e\xab\xcc\x30\xec\x44\x9e\x9f\x7a\xbe\x69\x5f\xf7\x8c\x5e\xda\xb6\xbe\xd9\x92\xbe\x89\x49\x9e\xfe\x34\x64\xec\x2e\x24\x33\x11\xfa\x77\x70\x52\x25\xa9\x7c\xd4\xab\xac\x69\x00\xde\x6d\xb1\x15\xba\xc2\x86\xe4\x8c\x59\x52\x82\x82\x10\x9a\xc5\x28\xef\x0c\xc0\xe5\xed\xc4\xca\xc2\xe1\xaf\xde\xff\xeb\xba\xd9\xe8\x94\x55\xf5\x65\xc6\x8d\xf1\x4c\xde\x6a\xd2\xa8\xaf\xe8\xba\xce\xaf\xdb\xf1\x5c\x2d\x66\x7b\xa6\xc6\x72\xa6\x53\x25\x6a\xe0\xe0\xcc\x90\x33\xfa\x0b\xbb\xa9\x46\x34\x86\x82\xea\x70\x70\x57\x54\xd8\x79\x8a\xb8\x33\x6d\xa2\x20\x95\xba\x0a\xb1\x78\x3e\x1c\xed\xdb\x87\xef\xc7\x71\x2c\xa5\xb3\xef\x73\x0d\xa1\x79\x3c\xb7\xd8\x5b\x18\x2c\x64\x81\xd3\xb3\xec\x5e\xef\x74\xf6\x76\xed\xc9\x5b\xcd\x87\x3f\x3c\x70\x7a\xeb\x0e\x45\xe3\xdf\xc3\xf8\x43\x58\x1c\x03\x6e\xc6\x19\xf8\x0b\x85\x38\x43\x03\x46\x08\xf7\xd3\x84\xa0\x44\xf0\xd0\x17\xfd\xf7\x5a\x97\xf0\xd1\x73\xd8\xf0\x8b\xef\x3d\x8e\x8a\x54\x3c\x3a\x42\x9c\x7c\x4d\x43\x4e\xf6\xfa\x45\x41\xff\x75\xab\x31\x00\x7b\x0f\x06\xa8\xf7\x90\xec\x27\xc1\x5d\x07\xb0\x1f\xb1\x34\xa8\x8e\x4d\xa3\x23\x44\xc9\x03\x82\xbb\x00\x43\xa8\xf8\xa0\x2a\xf6\xec\xad\xbf\xa6\x84\xaf\x80\x11\x3a\xd7\xbb\xd4\x8a\x2d\xdd\xda\x10\x95\xfb\x8b\xe8\x08\x65\xcd\x5a\xf8\xb7\xca\xa1\xb9\xa8\xaf\x59\x50\xbf\x09\x9b\x6f\xec\x4b\x9e\x32\x1b\x10\x7a\x3f\x38\xbf\x38\x19\xfd\x71\x79\x3a\xfb\x63\x3a\xfa\x65\x34\xbc\xfc\xe3\xd3\xf9\xf1\xa7\xcb\x8f\x17\xd3\xf1\xef\xa3\x13\x74\x84\xfa\x07\x9b\x75\x43\x1e\x63\x98\x92\x95\x69\xa2\x23\x74\x2b\x0d\x7e\x8f\xdc\x13\x2a\x1c\xe4\x33\x2a\xc8\xa3\x78\x6d\x67\xcc\x67\x34\x61\x11\x19\x44\x6c\xbe\xd7\x87\x8b\x62\xa3\xd9\x25\x9a\x8e\x86\xa3\xf1\xe7\xd1\x89\x8b\xfa\xe8\x47\xf4\xcb\xec\xe2\x7c\x50\x8a\x38\xbc\x5d\x95\x68\x5f\x6f\x16\x2a\xfc\x6b\xea\x76\x10\x14\xd9\xcb\x1b\x52\x48\x33\xd9\x2b\x45\xee\x68\x14\x73\xee\xa0\xc0\x13\x5e\x07\xb1\xf0\x09\x6f\xd1\x1e\xe1\x7c\x0d\x44\x93\x2d\x80\x6e\x51\xab\xbe\xe4\x65\x7a\x63\x2d\x32\x30\x8f\xc0\x4b\x16\x37\xcc\xe3\xc1\xfb\x4d\x90\xb1\x97\x24\x0f\x6c\x0b\x40\xb9\x79\x83\x8e\x0a\x96\x07\xa5\x50\xae\x0e\xae\x07\xf2\xa8\xf8\x16\x3d\xa9\xcb\xe8\x6d\x1c\xf5\x45\xf5\x16\x9a\x75\x68\x6f\x19\xdf\x03\x76\xc3\xa3\x83\xf7\x8a\xc0\x41\x54\x5c\xfe\xfb\x57\xf8\x1e\x85\x3f\xfe\xb8\x41\xf0\x4a\x45\xb2\xed\x55\xa8\xd8\xf9\x95\xac\xd0\xd1\x11\xea\x9f\x28\x41\xf6\xb7\xc0\x04\x9f\x4a\xf2\xe8\x08\xb5\xb0\x16\xcb\xf8\x5a\x41\xc1\x27\x5f\x07\xd0\xaa\x5c\x07\x0c\xf2\x29\x64\x53\xcb\x7e\x27\xf1\xd4\xcd\xaf\x42\x4d\x59\x4a\x48\x13\x69\x43\xdb\xca\x48\xd9\x1c\x3a\x42\x1d\x98\xbf\xb7\xa0\xc0\x88\x58\xb1\x4f\xd1\x39\xa7\xea\xbf\x0b\x96\x08\xb7\x56\xb5\xb3\x09\x1e\x26\x3d\x17\xfd\xed\x6f\xef\x36\x43\x7a\x62\xe1\xa2\xfe\x1b\x2f\x8e\x93\x37\x30\x9d\x15\xf3\xd7\xc0\x8b\x63\xf4\x23\xea\xbf\x91\xd3\x31\xd1\xeb\x64\x59\x51\xcf\x53\xda\xdf\xd8\xc7\x92\x88\x05\x0b\x5c\xd4\x9f\x5c\xcc\x2e\x37\x83\x2f\x88\x17\x10\x9e\xb8\xdb\xe9\xb6\x7f\xec\xfb\x24\x16\x7d\x17\xf5\xbd\x38\x8e\x20\x48\x0b\x19\x7d\xf3\x67\xc2\xb6\xa0\x0c\x3e\x7d\x38\xf9\x45\xa8\xd8\x87\x10\xa3\x89\xe6\x71\xff\xe1\xe1\x61\x1f\x96\xdf\xfd\x94\x47\x84\xc2\x4d\xf2\x60\x4b\xbc\x9f\x12\xc2\xf7\x8f\xe7\x84\x16\xc4\xf9\x29\x8f\xde\xb4\x16\x44\xf5\x45\xfd\xe4\x1b\x51\x7b\x69\xa1\xb0\xf2\x02\x97\x0b\x5a\x51\xd6\xbd\xae\xe5\xe6\x85\xb7\x69\x9d\x9c\x7c\x45\x47\xa5\x5f\x33\x00\xaf\x86\x24\x62\x4f\x5a\xac\xb6\x24\x71\x92\x6c\x31\x06\x01\xdf\x0d\x0b\x56\xb0\x68\xb7\xd7\x6c\xf5\xc5\xb6\x38\xf5\xe1\xc2\x65\x9a\xb8\x7d\x07\x71\x92\x0c\xe0\xee\x50\x9a\xc0\xad\xb7\xd7\x4f\x43\xf3\x51\xda\x54\xdf\x69\xae\xd7\x80\x56\x5a\x9c\x65\xd5\x56\x5f\xd4\x0f\x40\x27\x44\x8c\xc0\x10\x42\x3a\xdf\xeb\xa7\xe2\xf6\x1f\x16\x17\x4a\x7d\x51\x3f\xd0\x8e\xd1\xbd\x3e\x2c\x65\x7d\x4d\x7e\xfe\x22\xa5\x77\xaf\x51\x56\x8a\xe7\xc7\x23\x54\x14\xa0\x7c\x7b\x8c\x84\x06\x3a\xc2\x2d\x67\x44\x5d\x38\xd0\xf5\xeb\xf7\x5b\xb6\x02\x5f\x69\x10\x30\x4a\x2c\x0e\xa7\xfa\xa2\x7e\x36\x70\x61\xa9\x5e\x07\xce\xc9\xd7\x92\x61\xce\x19\xd7\x59\x2e\x0a\xb6\xe0\xdb\x30\x88\x11\x34\x02\xb3\x2a\x5b\xbf\xdf\xa2\x71\xc1\xfa\xad\x17\x46\x7b\x5b\xb4\x79\x22\x73\x30\x42\x62\x96\x88\x13\x4f\x78\xe8\x48\x77\xdb\x35\x53\xdd\xc0\x61\xdf\x67\xcb\xa5\x47\x83\x3e\x72\xe5\x4c\x2d\x0b\x5e\x90\x4e\x50\xc2\x03\x0f\x05\xd9\x53\xd4\xb6\xdb\x37\xe1\x09\x0d\xd6\x18\x8b\x75\x4d\xb5\x50\x95\xbf\xd7\x73\x31\xe6\xc5\xd1\x7c\x5d\xf8\xad\xe7\xca\x5a\x51\x35\x04\x2b\xb3\x05\xe3\x42\x06\xb9\xd3\x34\x22\x9d\xd1\xf5\x08\x84\x9a\xb8\x6e\x01\xb4\x31\xb2\xd6\x23\xea\x53\x46\xe7\x2a\x8c\x4e\xfc\x05\x09\x74\x0c\x72\xbf\x13\xca\x46\x8f\x31\x1c\x2a\x90\x5b\xb5\x6a\xef\x13\x6a\xcc\xdd\x4f\xb9\x6b\xd6\xca\x79\x15\x21\xb4\x3d\xdc\xd6\xd3\x07\x5d\x47\xf1\xc7\x81\x85\x60\xb9\x41\x67\x02\xd2\xb8\x88\xbb\x71\xf6\x05\x9e\xd9\xfa\x82\x5d\xf4\x05\x9b\xe9\x82\x2f\xd8\x41\x5f\xd4\xa5\xa1\x1a\x40\xe6\xbe\x2a\x00\x69\xa2\x35\xc0\xb0\x2c\x28\x00\xf2\x5a\xe5\xf9\x75\xe7\x96\xb9\xae\xbf\x32\x01\x32\x21\x7c\x19\x2a\x31\x66\xbd\xe6\x01\x36\x23\x55\xa2\xc1\xda\x54\xaa\x3d\x63\x55\x5f\xb2\x47\xd5\x8d\x64\x77\x4c\xef\xd9\x1d\xa9\x25\x5b\xc3\xaa\x32\x90\x07\x46\x3b\x2a\x45\x7b\x1e\x00\x3a\x2d\x86\x75\xd2\x78\x10\x40\x83\x2e\xdf\xbb\x01\x23\xb0\x77\x68\x35\x77\xad\xe3\xb6\x8c\xeb\x71\x64\x4f\x63\x55\xb7\x68\x5b\xc7\xd2\x71\x79\x97\xfc\xa3\x97\x4c\x25\x8c\xae\x0c\xa0\xec\x82\x1b\x49\xed\x6c\xcd\x39\x6b\xf3\x72\xba\x83\x70\x9a\xec\x13\x2f\x11\xfb\x87\x18\x5d\xe7\xce\xce\x38\x1e\x48\x22\xf6\xdf\x3e\x03\x07\x49\x4b\x1c\x05\x1d\x12\xc5\xf5\xba\x6b\x74\x95\xc0\x9a\x89\x3f\xac\xc4\x64\x79\x0f\xc9\x72\x89\x67\xaa\x81\x39\xbd\xf6\x39\x8f\xb6\xf4\x9d\x5e\xf7\x4d\x1f\xfb\x2c\xf6\xfc\x1b\x3e\xda\x55\x1b\x5d\x82\x55\xbe\x48\xa5\x98\xf3\xad\x44\x26\xe3\xdb\xa6\xc0\xc6\x41\x2d\xa8\x2d\x65\xa0\x0e\xe2\x99\x64\x1d\xfb\x3e\x5c\x2d\x1a\x07\xfa\x6d\xd0\x75\x6a\x79\x72\x6f\x4d\xf1\x59\x90\x3b\xfa\x8b\x58\xfa\x19\xcf\x4e\xf9\x34\x6e\xdc\x2b\xad\xa9\xdc\x6b\x6f\xf3\x23\x2a\x1d\xea\xdf\xea\xf1\x94\xce\x37\x51\xb2\x5e\xc7\x03\x25\x46\x39\x6a\x3d\x57\x62\x54\x6b\x7b\x06\x5b\xbc\xa8\xa2\x4d\x97\x3a\x89\xa6\x35\x5b\x48\x40\x08\x13\xbf\x39\xaf\x36\x60\xd4\x02\xa4\x4f\x91\xcd\xef\xd7\xf6\x1b\x75\xda\x53\x25\xb2\xbe\x1e\x20\xed\x37\x56\xec\xef\xab\xe8\xdc\x34\xb6\x58\x74\x7d\x3b\x6d\xb0\xce\x17\x55\x3a\x35\xb7\xfb\x6b\x36\x9d\x4a\x86\x0f\x26\xe5\xa1\x35\x78\xd0\xee\xa6\x3a\xb4\x76\x22\xb3\x9e\x3f\x60\xe7\x09\x6d\xca\x69\x94\x70\xb5\xf3\x96\xc0\xe1\x43\x7d\xf7\xe8\x09\xd8\xa6\x4d\x5c\xbf\x85\x62\xb1\x05\x2e\xff\xed\x46\xe2\xfd\xb7\xee\x71\x2a\x16\x8c\x87\x7f\x11\x63\xfb\x48\x1d\x1f\x6d\xb5\x6a\x18\xbc\xf1\x64\x52\x97\x5c\x7f\xb0\xa0\x69\x94\xe4\xbd\xae\xda\xbc\xd7\xfc\x76\xbd\x79\x4a\xd6\x6f\xe9\xe6\x79\xaf\xb9\xcf\x6c\xbc\x51\xa1\x6e\xda\xa9\xe1\xe7\xd4\x2f\xb2\x2a\x0b\xce\xb2\x0a\x4a\x0a\x12\x8f\x97\xde\xbc\xaa\x2b\xfe\xa8\x2b\xb3\xac\xd8\x22\x2b\x3d\xc5\x63\xce\xbd\x95\xf4\xe7\x8b\x45\xb0\x28\x35\x44\x55\x05\x00\xaf\x8a\x8d\x2a\x07\xbd\x22\x51\xf1\x8a\x53\x71\x03\xca\x8a\x47\x35\x0c\x6f\x65\xa3\x3c\x77\xb2\x0c\x92\xe1\x79\x9e\x65\x84\x06\x0d\x48\x9c\x65\x0a\x69\xae\xb9\xad\x26\xa8\xd4\x6d\x89\x07\x12\x9d\x94\x54\xdd\x97\x17\x46\x10\xc6\x1a\x62\x8d\x99\x2c\x43\xf7\xb0\x68\x35\xe1\xd5\xfe\xa8\xde\x11\x96\xaf\x62\xb4\xf7\xa0\x95\x90\x01\x40\x2d\xd2\xf2\xf9\x9c\xf5\x0d\x24\x4c\xd5\xa6\x71\x25\xbc\xd7\x94\xf3\x1d\x59\x39\xe8\x55\x49\x31\xc8\x78\x44\xef\xcf\xbc\x58\x85\x5d\x52\x60\xe8\xd5\x1d\x59\xc9\x43\xae\x8a\x3d\xd9\xa6\xde\xf6\xad\xed\x6f\x53\x1f\xa7\x21\xbd\xfb\xec\xf1\x64\x6d\x2f\x1b\xf1\xe3\x5f\xc7\xe7\xa3\xd9\x78\x66\xc8\x43\xbd\xd6\xa0\xf8\x87\x4d\xf0\x8b\x9f\xff\xf8\x79\x7a\xf1\x69\x62\x00\x56\x2f\x29\x68\x90\x93\xe9\xc5\x70\x34\x9b\x49\xb9\x2a\x89\x9a\x0f\x78\x7c\x66\x51\xba\x34\x66\xfc\x8a\xcf\xc1\x19\xf8\x24\x70\x8a\x42\x02\x35\xf9\x1b\xd4\x03\xc3\x22\x2e\x54\x6d\x5f\xdb\xb6\xf6\xa5\x45\xaa\xa5\xd1\x20\x60\xbb\x96\xfa\xf1\x13\x93\xfc\xd6\xf3\x0e\x9b\xcf\xac\x34\xf6\x66\xe5\x19\xd0\xaa\x1a\xd9\x4c\xb4\xbe\xca\xdb\x7d\x92\x42\x49\xa7\x12\x99\x71\xdb\xb7\xaa\x34\x8e\x89\xee\x26\x49\xb9\x7d\x0f\xb9\xdf\xba\x29\x86\x3f\x95\xfe\x65\x55\x35\x95\xe9\xbd\xc8\xd6\xbf\x31\x7e\x17\xd2\xf9\x49\xa8\xe3\xa8\x0b\x89\x2f\xbd\xd0\x82\x15\x03\xd8\x8a\x15\xbc\xa2\xfb\x30\x22\x73\x52\xc5\xf7\x75\x89\x14\x40\xc1\x76\x37\x8b\xe8\xba\xd7\x15\x01\xea\xef\x39\xb5\x5f\x4d\x32\xfd\xce\xd9\x3b\xd7\x95\x6f\x93\x49\xf3\x39\x21\x11\x01\x97\xa1\x3a\x41\x82\xa7\x04\xee\x61\x6f\x70\x4c\x7d\xc8\x2a\x80\x12\x79\x71\xe2\xad\x75\xe4\x1b\x5f\x7a\x86\x39\x16\xea\x83\x47\x28\x5c\x84\x93\x55\x22\xc8\x12\x3b\xb5\x63\xae\x9e\x43\xd3\x0d\xa5\x86\x87\x97\x2b\x35\x60\x29\x24\x5b\x28\x63\x5b\x41\x7b\x59\x86\x08\x0d\x50\x9e\xf7\xfe\x6b\x00\x7a\x76\x35\x60\x9c\x5c\x00\x00")
 
 func templatesAppTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -90,12 +93,52 @@ func templatesAppTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/app.tmpl", size: 23541, mode: os.FileMode(420), modTime: time.Unix(1466708457, 0)}
+	info := bindataFileInfo{name: "templates/app.tmpl", size: 23708, mode: os.FileMode(420), modTime: time.Unix(1792424994, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServiceMysqlTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x57\x6d\x6b\x23\x37\x10\xfe\x9e\x5f\x21\xf4\xa9\x05\xd7\xf8\x7c\xf4\x4a\x4d\x29\x38\x76\x12\x16\x9a\xd4\xc4\x69\x0a\x2d\xf9\x20\xaf\xc6\x46\xdc\x5a\xd2\x49\xda\x1c\xb9\xb0\xff\xbd\xa3\x7d\xd7\xbe\xd8\x97\x40\x8f\x8b\x59\x34\xf3\xcc\x3c\x1a\xcd\x3c\xab\x7d\x7d\x25\x1c\xf6\x42\x02\xa1\x16\xcc\xb3\x88\x81\x92\x2c\xbb\x20\xe4\x15\xff\x08\xa1\xcb\xbf\xb7\x0f\x70\xd4\x09\x73\x70\xad\xcc\x91\xb9\x47\x30\x56\x28\x49\xc9\x82\xd0\xf9\xec\xc3\xec\xa7\xd9\xaf\xf8\x9f\x4e\x0a\xf7\x95\x92\x5c\x38\xb4\x5b\xba\x28\x43\xe0\xea\xc6\x88\x67\x0c\xe0\x97\x08\xbd\x96\x8b\xc5\xd5\x97\x94\x25\xde\xe5\x5f\xbf\x72\x0f\x7b\x7c\xac\xbd\x48\x36\x21\xd4\x99\x14\x9f\x9e\x48\x96\xc7\xc8\xca\xf0\x1b\x66\xd8\x11\x1c\x52\x68\x87\x5f\x26\x89\x8a\x11\xc9\xb7\x4e\x19\x76\x80\x96\x0d\xad\x0f\x2f\x1a\x72\xb6\x77\xe9\x71\x07\xa6\x64\x9a\x9b\xd6\xb0\x67\x69\xe2\x72\xeb\x87\x59\x68\xb1\xb1\x11\xda\x55\x3b\xad\x53\x10\x5b\xe4\x20\x56\x7c\x03\xf2\xc3\xcd\xe5\x8f\xb4\x44\x65\x15\x9c\xae\x99\x63\x3b\x66\xc7\x78\x6c\x9d\x11\xf2\x30\xc6\x83\x69\x7d\x8a\x48\xe9\x4a\x78\x99\x83\x48\x2c\x48\x9f\x42\x24\xad\x63\x32\x86\x3c\xe9\x7b\x68\xf0\xdd\xd4\xcd\xa7\x47\x11\x1b\x75\x8a\x4e\x95\x87\xc4\x09\xb3\x96\xec\x95\x69\x31\x53\x1c\x6c\x9f\xda\x2d\xa6\x10\xcb\x7f\xde\xc5\x6a\x8f\x5d\x03\xa7\xf8\xe4\xc1\x75\x02\x84\x3d\x33\x91\xb0\x9d\x48\x84\x7b\x21\xdf\x94\x1c\xa8\xd1\x06\x19\x7f\x55\x86\xbf\x81\x49\x98\x6c\x8b\x03\x03\x86\xe8\x2a\x4e\x3f\x43\xd3\xf7\x9d\x04\x67\xe3\xa3\xc3\xca\x00\x82\x89\x90\x44\x17\x71\x88\x4d\x77\x12\x9c\x1d\xaa\xce\x50\x71\x7c\xd3\x7e\x05\xfe\xc8\x92\x14\x8a\x61\x2b\xc6\x6a\x52\xf9\x92\xa7\x1e\xe5\x6d\x99\x62\x90\xf2\x1f\xc2\xba\xdf\x50\x11\x70\x80\x57\xf3\xc5\xa2\xf0\x5d\x2c\x22\xfe\xfb\x89\x6d\x3c\x6e\x56\x35\xf1\xb1\x74\xe3\x85\x22\x6f\x48\xdb\xf4\xc9\xa9\x53\xf3\x7c\xba\x05\xed\xf1\xfa\x0b\xc5\x30\x9f\xac\xff\x61\x84\xcb\xb6\x49\xab\x14\xbd\xe4\x8f\x3a\x1e\xae\x7f\x53\x03\xdc\x84\x2f\xc0\xe9\xb2\x0f\x46\x5e\x09\x6e\xc2\xe8\x03\xe7\xb5\x8a\xd6\xf7\xe4\x12\x25\xef\x73\x3b\x43\xa7\x73\xab\xe8\x81\x42\xff\x99\x3a\x9d\xba\x50\xfd\x95\x71\x1f\x3f\xce\x3e\x3d\xc4\x7a\xc9\x8b\xe4\x98\xc5\x37\x65\xf3\x42\xb8\x01\xb7\x74\xae\xe8\xd1\x4a\x53\x7c\x9f\x5e\x49\xae\x95\x90\x6e\xea\x91\x60\x6d\xfe\x4a\x68\x4f\x58\x13\xdb\x3f\xbe\x2f\x76\x8e\xec\x04\xbe\x92\xcf\xb7\x2f\xf6\x4b\xd2\xd6\xf2\x20\x72\xf9\xd2\xaa\xed\x83\xe8\xb6\xc4\x0c\xa1\x6b\xfb\x20\xba\xdd\x84\x43\xe8\xda\xee\xd1\xc1\x29\xdc\x83\x55\xa9\x89\x21\x38\x87\x2d\xc4\xa9\x41\x39\xbc\x31\x2a\xd5\xe7\x1a\x2c\x74\x6e\x35\xc1\xc6\x28\x0d\xc6\x09\x08\x35\x02\x2d\xb9\x6b\xa7\x99\x8e\x7e\x1f\xa4\xba\x59\x4c\xda\xee\x41\x86\x48\x1e\xf2\xe3\xc5\x43\x6a\xf9\x10\xbf\xd9\x48\x63\x4a\xa7\x62\x95\xf8\x80\x2e\xd6\xfe\xec\xae\x8d\x3a\x96\x07\x4e\xfd\xf9\xfb\xb5\x07\xd5\x5d\xf1\xbd\x1e\xe9\xa0\x66\xd5\x04\xd4\x25\x2b\xfe\x3d\x05\xd4\xd0\x29\xe2\x5d\x18\x6d\x01\xb2\x11\x21\x3b\x57\xda\xfb\x35\xfe\xac\x2f\xdb\xce\xdf\x55\xda\x00\xf2\x86\x12\xe7\xa0\x88\xdb\x66\x18\xa2\x7d\x31\x08\x95\xe2\x4e\xba\xe5\x2e\x37\xdc\x11\xe6\x66\xa7\x23\x8e\x34\x2c\xe7\xc9\x5a\xd5\x43\x78\xb6\x50\xcd\xb8\x7e\x4f\x95\x86\xee\x81\x35\xcf\x9e\x31\xdc\x12\x6d\x92\xad\xfc\x55\x26\xc0\x06\xb7\xa9\x51\x5c\xc4\x41\x3a\xb1\x17\x60\xc2\xc4\x7e\x3f\x5b\xc7\xe2\xcf\x77\xc5\xac\x76\xe0\x77\xf5\x84\xf7\x25\x65\x32\xda\x04\x3d\x54\xbb\xa7\x3a\xc0\x2b\x79\xc0\x0b\x7e\xdd\x2b\x74\xc0\x58\xdd\xea\xd1\xe7\xe7\xe9\xa7\xe9\xfc\x97\xd0\xe9\x96\x59\xbc\x74\x87\x7a\xd4\x17\xa1\x11\x48\x28\x82\x7d\xe5\x0b\x61\xcd\xed\xb0\xf6\xad\xd6\x3a\xae\xe1\xb4\x07\x96\x74\x97\x88\x38\x79\x59\xc6\x28\x81\x56\xec\x12\x18\xba\x1f\xf9\x01\x29\x7a\xa1\x6a\xbd\x83\x9e\x87\x76\x7c\x17\x06\x32\xd5\xfd\x64\x09\x55\x12\x35\xe5\xa9\xdf\xf6\x17\xd5\x6f\x76\x81\x1f\x5b\x20\xb9\xff\xbe\xfa\x2f\x00\x00\xff\xff\xf5\x59\xa3\x5b\x77\x0d\x00\x00")
+var _templatesServiceElasticsearchTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9d\x57\x51\x6f\xdb\x36\x10\x7e\xcf\xaf\x20\xf8\xb4\x05\x6e\xe6\xb8\x2d\x86\x09\xc3\x00\xc7\x71\x02\x17\x45\x6b\xc4\x46\xf6\x50\xf8\x81\x91\x4e\x2e\x31\x89\x54\x49\x2a\x45\x6a\xe8\xbf\xef\x48\x49\x16\x29\xc9\x5e\x3a\x20\x0a\x14\xde\xf1\xee\xd3\x77\x1f\xef\x98\xc3\x81\x24\x90\x72\x01\x84\x6a\x50\xcf\x3c\x06\x4a\xaa\xea\x82\x90\x03\x3e\x84\xd0\xf9\xdf\x9b\x2d\xe4\x45\xc6\x0c\xdc\x49\x95\x33\xf3\x08\x4a\x73\x29\x28\x89\x08\x9d\x4d\xaf\xa7\x6f\xa6\x7f\xe0\x0f\x9d\xd4\xee\x0b\x29\x12\x6e\xd0\xae\x69\xd4\x84\xc0\xd5\xb5\xe2\xcf\x18\xc0\x2e\x11\x7a\x27\xa2\x68\xf9\xad\x64\x99\x75\xf9\x62\x57\x1e\x20\xc5\xd7\xa3\x17\xa9\x26\x84\x1a\x55\xe2\xdb\x8e\x54\x2e\x46\xd5\x84\x5f\x33\xc5\x72\x30\x08\xc1\x0f\xbf\x12\xda\x30\x11\xc3\x42\x96\xc2\x78\x06\x34\x6d\x5f\x0a\x9b\x96\x7e\x2a\xf3\x27\x50\x0d\x4a\x67\xb9\x85\x94\x95\x99\x75\xa7\xd7\xe1\xba\x8e\x15\x2f\xec\x37\x58\xdb\xf6\x2b\x10\xe1\x36\x13\x99\x92\x84\x19\x46\x84\x4c\x40\x13\x2e\x88\x41\x5b\x22\x73\xc6\x05\x6d\xf6\x57\x93\x3e\xa6\x06\xc0\x08\xa4\x8d\x51\x5c\xec\x4f\x40\x32\xb3\x2b\x9d\xb3\x2c\xbb\x82\x8c\x69\xc3\x63\x0d\x4c\xc5\x5f\xcf\xe0\x6c\xf3\x11\x83\xe1\x49\x2a\x95\x87\x75\x88\xce\x2b\xc8\xeb\x80\x05\xb9\x16\x0a\x70\xb3\x65\xa0\xa8\xe3\x10\x5d\x3e\x09\x30\xfa\xc4\xc7\xa4\x58\x6b\xf0\x6d\xf3\x2c\x93\xdf\x21\x79\x64\x59\x09\xb5\x0a\xea\x7a\x4f\x5a\x5f\xb2\x1b\x40\xde\x34\x29\x46\x21\x7f\xe4\xda\xfc\x89\x52\x45\x65\x2d\x66\x51\x54\xfb\x46\xd1\x2a\xf9\xeb\xcc\x67\x3c\xae\x17\x47\xe0\xa7\xd2\x9d\x26\x8a\xfc\x44\xda\x9a\x09\xbb\xe3\x14\x1c\xd2\xe0\xe9\x13\x3a\xc0\xd5\x1e\xbf\x9f\x97\xd4\xfb\xab\xf7\x67\xc8\x58\xfa\x3a\x23\xcf\x4d\x96\x61\x7a\x99\x95\x39\x6c\xf8\x0f\xf8\x3f\xe7\x6c\x7a\x0e\xc0\xcd\x86\x3c\xbb\xe8\x44\x63\x78\x27\x61\x60\x88\xe5\xa8\x63\xf2\xcb\xfd\xcd\xaf\x23\x90\x8a\x78\x1c\x4b\x57\x18\x64\xd6\x56\xe5\xbc\x16\x46\x23\x2f\x78\xa2\xc2\xe8\x23\x22\x5a\xac\x6e\x1f\xc8\x4d\x26\xe3\x7f\xfc\x0c\xbd\xa2\xb4\xd1\x83\x7e\xf6\xb9\x34\x45\x69\xc2\x5e\x29\x95\x79\xf7\xee\xed\x36\x2e\xe6\x49\x9d\x1b\x93\xd8\x83\xd2\x75\xcf\x7b\x30\x73\x63\xea\x73\x73\x5b\xf7\x9f\x49\xfb\xb6\x14\x49\x21\x39\xb6\x41\xdb\x3b\xfd\x13\x7f\x0c\x6b\xdf\xc2\xb0\x14\x0d\xb4\x87\xec\x01\xb4\x2c\x55\x0c\x01\xb6\x0d\xc4\xa5\xe2\xe6\xe5\x5e\xc9\xb2\xf8\x2f\xd2\x43\x67\x8f\x98\xb5\x92\x05\x28\xc3\x21\x3c\xcc\x68\x71\xae\x3d\x82\x83\x06\x48\xda\x19\x35\xf1\xb7\x05\x99\x56\x62\xaf\x40\xbb\xae\xe2\xf9\x10\xfb\xbd\xab\x02\x53\x1b\x19\xcb\xcc\xb5\xd8\xb8\xb0\xb4\xdd\x29\x99\x37\x94\x38\x1e\x70\x69\x2b\x7b\x0b\x56\x05\xab\xa2\x26\xad\x19\x56\xad\x36\x2c\xc9\x5e\x9e\x5d\x00\x0c\x9d\x50\x75\xbd\x6d\xd4\xdb\x50\x0d\x34\xd7\xd4\xf3\x0c\xb7\x3e\x1f\x51\xd4\xd6\xff\x35\xf4\xce\x63\xac\xa7\x5e\xcb\x8c\xc7\x43\x6b\xd0\x5b\xec\x64\x9f\xbd\xc1\xe1\x7e\xfd\x7b\x40\xb5\x25\xdb\x60\x7f\xca\xc1\x0d\xda\x90\x61\xd2\x8b\xe7\xdc\x97\x69\x0a\xb1\xe3\xd2\x35\xfd\x5e\xb4\x76\x18\x89\x98\x17\x2c\xab\x99\xc2\xaf\xb4\xee\x97\xb4\x63\x25\xf8\x86\xa3\x30\x74\x74\x39\x16\xae\x55\x6e\x77\x5e\x3e\x48\xc7\xe8\x17\xdb\x7e\xed\x6f\xa6\x44\xc4\xbe\xeb\x08\x23\xe0\x42\x57\x1c\xc7\xef\x03\xec\x5d\x43\xb6\x97\x90\x11\x33\x72\x68\x6f\x19\x58\xd6\xda\xa3\x9e\xff\xbf\x5d\xda\xf3\xb6\x0b\xa4\xe0\xd7\xb7\x11\x87\xf7\x57\xf0\x6d\xb6\xf9\x7d\x2e\xfa\xd7\xa6\xce\xb6\x14\xec\x29\x03\xab\x24\x3b\x26\x7b\x05\x09\x5b\x72\xa7\xb4\x6e\xb9\x4f\x64\x63\x6b\x65\xb5\x2f\x66\xf4\x34\x32\x5f\x6e\x8b\xac\xd4\x78\xf9\xc2\x4b\x5e\xca\xf7\x43\xa4\x83\x7b\xd8\x11\x4c\x68\x19\xe0\xe9\x5f\x96\x06\xfb\xea\x89\x5b\xbd\x0e\xa5\x37\x22\x3b\x36\xda\x5b\x6b\xb8\x0f\xbb\xf7\x49\xde\xc3\xae\x92\xf4\x6f\xab\x61\x7b\xc3\x36\xb0\xeb\x1f\x14\x37\xc2\xbd\x8d\x56\x8a\x1b\xc8\xea\xe3\x80\x32\x9c\xd6\xea\xb2\xcb\xab\xb4\x5e\x6a\xaf\x1b\x7d\x59\x7b\x69\xc3\x7b\xc9\xf0\x8c\x0c\x5c\x69\x5f\x85\x16\xab\x7d\x2e\xc6\x74\x5a\x85\x73\xea\xc2\x3e\x87\x03\x01\x91\xd8\x7f\x0b\xfe\x05\x4d\x64\x48\x7a\x2e\x0c\x00\x00")
+
+func templatesServiceElasticsearchTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServiceElasticsearchTmpl,
+		"templates/service/elasticsearch.tmpl",
+	)
+}
+
+func templatesServiceElasticsearchTmpl() (*asset, error) {
+	bytes, err := templatesServiceElasticsearchTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/service/elasticsearch.tmpl", size: 3118, mode: os.FileMode(420), modTime: time.Unix(1792426275, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServiceMemcachedTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x56\xc9\x6e\xdb\x30\x10\xbd\xe7\x2b\x06\x3c\xbb\x41\xec\x5b\x85\xa2\x80\xab\x2c\x10\xd0\xa4\x46\xec\xba\x87\xc2\x07\x45\x1a\x3b\x44\x25\x52\xe5\x92\xa2\x30\xf4\xef\x25\xa9\xc5\x22\x65\xc7\x09\xd0\xc0\x31\x64\x72\xe6\xcd\xe3\x9b\x45\xdc\xef\x21\xc7\x2d\x65\x08\x44\xa2\x78\xa1\x19\x12\xa8\xeb\x0b\x80\xbd\xf9\x07\x20\xf3\x1f\xcb\x15\x96\x55\x91\x2a\xbc\xe5\xa2\x4c\xd5\x1a\x85\xa4\x9c\x11\x88\x80\xcc\xae\xa6\x57\x1f\xae\x3e\x9a\x0f\x99\x34\xe6\x31\x67\x39\x55\x66\x5f\x92\xa8\x85\x30\xab\x0b\x41\x5f\x0c\x80\x5d\x02\x72\xcb\xa2\xe8\xe6\xb7\x4e\x0b\x6b\xf2\xd3\xae\x3c\xe2\xd6\x3c\xf6\x56\x50\x4f\x80\x28\xa1\xcd\xd3\x06\x6a\x87\x51\xb7\xf0\x8b\x54\xa4\x25\x2a\x43\x61\x08\x9f\x30\xa9\x52\x96\xe1\xea\x6f\x85\x83\x75\xb3\xd3\xae\x90\xa5\x12\x94\xed\x5a\x92\x6e\xe7\x1a\xb7\xa9\x2e\x94\xdd\xcc\xd2\xec\x19\x2f\xd5\xec\xb2\xa4\x99\xe0\xbe\x91\xcc\x04\xad\xec\x79\xac\xe1\xea\x19\x41\x19\x44\xe0\x5b\xa0\x6d\x4c\x50\x1c\xb4\x44\xd2\x3a\xd5\x9d\x37\x79\xd0\x65\x6c\x81\x1f\x78\x8e\xf2\x38\x2b\x63\xf2\x84\xe2\x04\xab\xe9\x19\x22\xcc\x39\x5b\x2a\x8e\x3f\x30\x1b\xc7\xd0\x02\x65\x7e\x64\x85\x96\x46\xa5\x31\xab\x41\x26\xde\xa6\x92\x17\x37\x16\x68\x9c\x6d\x90\xaa\xc1\x01\xa9\x9f\x18\x2a\x79\xe2\x0c\x5b\x93\x64\x1c\xee\xcd\x8b\x82\xff\xc1\x7c\x9d\x16\x1a\x9b\xf4\x37\x89\x9e\x74\xb6\xb0\x19\x51\x5e\xb6\x21\x8e\x52\xfe\x4a\xa5\xfa\x64\x6a\xd4\x94\x54\x3c\x8b\xa2\xc6\x36\x8a\x92\xfc\xf3\x2b\xc7\x58\x2f\xe2\x9e\xf8\xa9\x70\xa7\x85\x82\x77\x84\x6d\x94\xb0\x1e\xa7\xe8\x40\xcb\x27\x14\x74\xc4\x6b\x5d\x65\xc7\x25\x38\xd0\x30\x38\x96\xc3\xeb\x27\x3f\x8a\x1c\xd3\x5c\xf8\xe8\x47\x24\x8b\x93\xeb\x47\xf8\x52\xf0\xec\xd7\x30\x42\x50\x3c\x1d\xba\xd7\xb6\xdf\xb4\xaa\xb4\xf2\x47\x02\x17\x6a\x3a\x9d\x4d\xa7\xab\xac\x9a\xe7\x4d\x74\x13\xc6\x16\xc6\x61\x4c\xdc\xa1\x9a\x2b\xd5\xd4\x89\x6b\xa6\xb8\xad\xeb\x89\x9b\x34\x5b\xba\xd3\x22\xb5\x14\x6f\x58\x5e\x71\xca\xd4\xa5\x85\x42\x29\xdd\xe4\x18\x96\xfd\x20\x9a\x7d\xfe\x4f\xd1\x1c\x54\x13\xca\x3b\xef\x23\x4a\xae\x45\xe6\xf5\x3d\x59\x62\xa6\x05\x55\x7f\xef\x04\xd7\xd5\xb9\x54\xfa\xc6\x03\xb9\x17\x82\x57\x28\x14\x0d\x66\x8a\xd9\x71\xa6\x41\xda\x4a\x2c\xdd\x74\xc8\xa1\x1b\xee\x93\xa1\x8b\x17\x25\x61\x3b\x27\x9d\x39\xff\xc0\x06\xac\x3a\x49\x65\xc2\x2a\x9e\xf1\xc2\x82\xaa\xac\xb2\x92\xdc\x0a\x5e\xb6\x5a\x12\xa7\xad\x5d\x5c\xf1\xd1\x92\xad\xad\xa4\x6a\x64\x6e\x27\x7d\x57\x71\xbd\x70\xcd\xdf\xc6\x23\x67\x8c\x4c\x2d\x07\x6e\x64\xe0\x50\x8f\x2a\xd9\x65\xad\x69\xc6\xb3\x2a\x17\xa9\x54\xd4\x39\x74\xfd\xfb\x0e\xad\x03\x99\xef\x0f\x32\x3b\x24\xd8\x05\x50\xfd\x5c\x49\x72\x79\x28\xb8\x64\xdb\x14\x5b\x37\x6b\x26\xa1\xee\xed\xb9\x83\x91\x74\x38\xf0\x09\x43\xe2\xab\x7a\x5e\xb2\xae\xd0\xdf\x28\x97\xdf\x1c\x6f\xd1\x6b\xae\x15\xbf\xa7\x8c\x8b\xf6\xf6\xf0\xbd\xda\x89\x34\xb7\xf0\x76\xfc\x7b\x3a\xf5\x2f\xcd\xee\x6d\xde\x9f\xce\x7b\xcd\xfb\x22\x8c\x32\xff\x60\xae\x09\x9e\xf3\xa8\x34\x02\x80\x1b\xb6\x33\x97\x20\xaf\x67\xfc\xfc\x8d\xde\xe7\x3d\xb4\xbf\x13\xe0\x06\xed\x10\x14\xb8\xdf\x80\x79\x78\x23\xf2\xa7\x80\xe9\x96\xcd\x38\x93\x17\xdd\x77\x7d\xb1\xdf\x03\xb2\xdc\x5e\xdf\xfe\x01\x07\x68\x72\x28\xd6\x09\x00\x00")
+
+func templatesServiceMemcachedTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServiceMemcachedTmpl,
+		"templates/service/memcached.tmpl",
+	)
+}
+
+func templatesServiceMemcachedTmpl() (*asset, error) {
+	bytes, err := templatesServiceMemcachedTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/service/memcached.tmpl", size: 2518, mode: os.FileMode(420), modTime: time.Unix(1792426275, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServiceMysqlTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\xdf\x6b\x1b\x39\x10\x7e\xcf\x5f\x31\xe8\xe9\x0e\xdc\xe0\xa6\x5c\x8f\x2e\xc7\x81\x63\x27\xc1\x70\xc9\x19\x3b\x75\xe1\x4c\x1e\xe4\xdd\xb1\x23\x22\x4b\xaa\xa4\x4d\x71\xc3\xfe\xef\x87\xf6\x97\x56\xbb\x6b\xbb\x49\xc0\x2d\xce\xce\xcc\x37\xdf\x7c\x9a\x19\xad\x5f\x5e\x20\xc1\x0d\x13\x08\xc4\xa0\x7e\x66\x31\x12\xc8\xb2\x33\x80\x97\x33\x00\x00\x32\xfa\xb6\xb8\xc7\x9d\xe2\xd4\xe2\xb5\xd4\x3b\x6a\x97\xa8\x0d\x93\x82\x40\x04\xe4\x62\xf8\x71\xf8\x61\xf8\xe5\xc3\xf0\x0b\x19\x14\xee\x63\x29\x12\x66\x99\x14\x86\x44\x25\x04\x00\x99\x69\xf6\x4c\x2d\xba\x47\x40\xae\x45\x14\x5d\x7d\x4f\x29\x77\x2e\x2b\xf7\x64\x8e\x1b\x12\x79\x2f\xc8\x06\x40\xac\x4e\x91\xc0\x03\x64\x83\x0a\x64\x21\xa8\x32\x8f\xd2\x7a\x94\xbb\xfc\x8f\xd5\x51\xd0\x3a\x2a\x47\xcd\x11\xdd\xbf\x1c\xb4\xc4\x26\x33\xaa\xe9\x0e\x2d\xea\x80\xf4\x88\x73\x19\x53\x8b\xc9\xc2\x4a\x4d\xb7\xd8\xb0\x01\x90\xfb\xbd\xc2\x5c\x83\xbb\x74\xb7\x46\x5d\xd6\xef\x3e\x64\x82\x1b\x9a\x72\x9b\x5b\x3f\x0e\x43\x8b\x89\x35\x53\xb6\xd2\xaf\x4e\x01\xa6\xc8\x01\x86\xfd\x44\xf8\xed\xe6\xf2\x77\x52\x46\xf9\xfa\x27\xd4\xd2\x35\x35\x87\x78\x2c\xac\x66\x62\x7b\x88\x07\x55\xea\x18\x91\xd2\x15\x92\x32\x07\x08\xba\xc3\x2e\x85\xa9\x30\x96\x8a\x18\xf3\xe2\xdf\x42\x23\x59\x9f\xdb\x8b\xf3\x1d\x8b\xb5\x3c\x46\xa7\xca\x03\x31\xa7\xc6\xc0\x46\xea\x06\x33\x99\xa0\xe9\x52\xbb\x4d\xb9\x65\xa3\xff\xde\x24\xce\x86\x72\x83\xc7\xf8\xe4\xe0\x8a\x23\xd0\x67\xca\x38\x5d\x33\xce\xec\x1e\x7e\x4a\xd1\xa3\xd1\x8c\x1a\xf3\x43\xea\xe4\x15\x4c\xc2\x64\x0b\xd4\xcf\xa8\x41\x55\x38\xdd\x0c\x7e\x9a\x5a\x09\x4e\xe2\x47\x40\xc6\x1a\xa9\x45\x60\x02\x54\x81\x03\x26\x5d\x0b\xb4\x26\x8c\x29\xce\xac\x4f\x1c\xd7\xb4\x3f\x30\x59\x52\x9e\xa2\x1b\x98\x55\x39\xac\x83\xca\x17\x1e\x3a\x94\x9b\xb3\xfb\x6b\x9c\xeb\xfc\x47\x4a\x99\xa3\x1b\x1b\x84\x8d\x96\x3b\xb0\x8f\xcc\xc0\x9a\xc6\x4f\xa9\x1a\x80\x46\xc5\x69\xcc\xc4\x16\xec\x23\xd6\xbd\xd3\x95\x72\x51\x96\xde\x4b\xeb\x1f\x66\xec\x5f\xa3\x6f\x8b\x28\xba\x1a\x5f\x44\x51\xe1\x1b\x45\xd3\xe4\xef\x23\x9c\x96\xb3\x71\x2d\xe8\xa1\x74\x87\x0f\x10\x5e\x91\xd6\xf7\xef\x21\x3a\x50\xf2\x69\x1f\x74\x87\xd7\x57\x83\x3a\x9f\xf8\x7e\x46\xef\x5a\x2d\x65\x3b\xa7\x55\x8a\x4e\xf2\xa5\x8a\xfb\xdb\xc2\x6b\xb0\x9c\x8d\x9d\xee\x87\xd2\x14\x65\xf6\x22\x8f\x59\xa2\x43\xf4\x6e\x20\x8c\xa7\x93\x39\x5c\x72\x19\x3f\x91\x41\x97\x46\x59\x7d\x69\x08\x6f\x8e\x7f\x53\xab\xd2\xa0\x7f\xc8\x4c\x6a\xfb\xe9\xd3\xf0\xf3\x7d\xac\x46\x49\x91\x1c\x48\x3e\x2c\xfe\xe2\xba\x41\x3b\xb2\xae\xbb\x57\x7e\xd7\x91\x01\x90\x2b\x91\x28\xc9\x84\x3d\x77\x91\x68\x4c\x71\x5d\x65\x83\x1e\x6c\xf7\xf5\x6d\xd8\x79\x64\x0b\xf8\x4a\x3c\xdf\xee\xcd\x77\xde\xbc\x63\x02\xe4\xf2\x36\xad\xed\xbd\xd1\xcd\xd5\xd7\x17\x5d\xdb\x7b\xa3\x9b\x4d\xd8\x17\x5d\xdb\x21\x6b\x9d\xc2\x1c\x8d\x4c\x75\x8c\xc1\x39\x2c\x30\x4e\x35\xb3\xfb\x1b\x2d\x53\x75\xaa\xc1\x42\xe7\x8a\x5a\xbe\x6b\xa5\x42\x6d\x59\x80\xed\x3e\x24\x77\x6d\x35\xd3\xce\x69\x08\xd5\x7b\x94\x87\x69\xd3\x99\x8a\x6d\x7e\xbc\x11\xac\x1a\x3e\xe0\xea\x9e\xaa\x99\x96\x56\xc6\x92\x3b\x86\x36\x56\xae\x2f\xae\xb5\xdc\x95\x07\x4e\xdc\xf9\xbb\x67\xf7\xb2\xfd\xc4\xf5\xfa\x54\x05\x9a\x55\x13\x50\x4b\x56\x7c\x1e\x02\x6a\x4b\x15\x4f\x93\x76\x18\x69\x04\x54\xdf\xfc\x99\x15\xbb\xf0\x94\xb4\xf3\xc9\x22\x8a\x26\x97\x4d\xe7\x5f\x92\x36\x08\x79\x85\xc4\x79\x9e\x69\x62\xfc\x30\x4c\x5d\xd7\xae\xfc\x95\xd9\xf4\x87\x46\xc1\xad\xc5\x0c\xd9\x09\xc7\xa6\x3a\x00\x0f\x47\xb5\xaa\x87\xf0\xa4\x50\x7e\x5c\xbd\xdf\x04\x39\xba\x5d\x3a\x93\x9c\xc5\xfb\xe0\x8d\xb6\xe1\xf5\x55\x25\xd4\xe2\x3c\xbf\xf5\xf0\xb8\xeb\x61\xd9\xfb\x5e\x78\xeb\xc2\x3b\xc6\x50\x23\xe2\xd9\x8f\xdd\x3b\x5b\x10\x5b\x59\xf2\xb9\x3b\x18\x37\x4d\x50\x58\xb6\x61\xa8\xbb\xe7\xe7\x0b\x69\x52\x72\xd2\xdd\xc9\x62\x53\x40\xd6\x31\x2d\x2c\x8d\x9f\xee\xca\x8d\xf1\xd0\xc9\x7b\x57\xef\x9a\xf7\x64\x6a\x6e\xc4\x6e\x8e\x0a\xed\xd5\xb5\xd5\x0f\x21\x3b\x42\xa4\x2f\xa3\x9f\x1c\x5f\x60\x05\xea\x6d\xa4\x15\x78\x25\xb6\x4c\x60\x3d\x60\xa4\xc7\x58\xfd\xf0\x8b\x80\xfc\x71\xfe\xf9\xfc\xe2\xcf\xd0\xe9\x96\x1a\x8b\x3a\x5c\xe2\xef\x11\xb6\xb9\xee\xdb\x65\xfa\x5c\xe1\x95\xd3\xbd\x67\xc2\x30\xff\x1b\xa1\xf6\xad\x9e\xb5\x5c\xc3\xdd\x1a\x58\xd2\x35\x67\x31\xdf\x8f\xe2\x18\x8d\x61\x6b\x8e\x7d\x6f\xc9\x6e\xe3\x17\x83\x52\x0d\xfa\x56\x5d\x84\xf6\xe5\x6c\x1c\x5c\x0a\x9d\x5f\xae\x4d\x63\xae\x42\x77\xc9\x9c\x55\xff\x67\x67\x2f\x2f\x80\x22\x81\x2c\x3b\xfb\x7f\x00\x28\xbe\x56\x85\xd3\x0f\x00\x00")
 
 func templatesServiceMysqlTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -110,12 +153,12 @@ func templatesServiceMysqlTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/service/mysql.tmpl", size: 3447, mode: os.FileMode(420), modTime: time.Unix(1465598780, 0)}
+	info := bindataFileInfo{name: "templates/service/mysql.tmpl", size: 4051, mode: os.FileMode(436), modTime: time.Unix(1792429462, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServicePostgresTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x58\x5b\x4f\x23\x37\x14\x7e\xe7\x57\x58\x7e\xd9\x56\x4a\xd3\x40\x97\x87\x8d\xaa\x4a\xb9\x00\x1a\xa9\xd0\x88\x50\x2a\xb5\x42\x95\x33\x73\x12\x59\x4c\xec\xa9\xed\xc9\x6e\x36\xca\x7f\xef\xf1\xdc\xed\x99\x49\x80\x08\x42\x98\x73\xfb\x7c\x2e\x9f\xed\x1c\x0e\x24\x82\x35\x17\x40\xa8\x06\xb5\xe3\x21\x50\x72\x3c\x5e\x10\x72\xc0\x5f\x42\xe8\xe4\xaf\xe5\x13\x6c\x93\x98\x19\xb8\x95\x6a\xcb\xcc\x33\x28\xcd\xa5\xa0\x64\x4c\xe8\xd5\xe8\x72\xf4\xd3\xe8\x0b\xfe\xd0\x41\xae\x3e\x93\x22\xe2\x06\xe5\x9a\x8e\x0b\x17\xf8\x74\x1a\x33\xf1\x7a\xcf\xbe\xa1\x54\x40\x58\x89\x09\xbd\x15\xe3\xf1\xcd\x7f\x29\x8b\xed\xff\xff\xd8\x27\x8f\xb0\xc6\x8f\xd4\x53\x26\xc7\x01\xa1\x94\xbc\xe0\xdf\xd2\xe7\x42\xf1\x1d\x82\x3a\xe3\xa7\xd4\xca\x1c\x18\x95\x42\xe6\x24\xf3\x51\xb8\xa2\x0b\xa6\xd8\x16\x0c\x2e\xab\x09\x79\x12\xc7\x32\x44\xcb\x68\x69\xa4\x62\x1b\x68\xc8\x50\xfa\xb4\x4f\x20\xcb\xc0\x43\xba\x5d\x81\xa2\x83\x5a\x34\x87\x35\x4b\x63\x93\x49\x2f\x47\xae\x44\x87\x8a\x27\xa6\xcc\x5e\x15\x82\xe8\x3c\x06\xd1\xfc\x3b\x90\x1f\xee\xa6\x3f\xd2\xc2\xaa\x5e\xee\x9c\x19\xb6\x62\xba\x0f\xc7\xd2\x28\x2e\x36\x7d\x38\x58\x92\x9c\x02\x52\xa8\x92\xa8\x88\x41\x04\x26\xa4\x0d\x21\x10\xda\x30\x11\x42\x16\xf4\x23\x30\xa2\xd5\xd0\x5c\x0d\xb7\x3c\x54\xf2\x14\x9c\x32\x0e\x09\x63\xa6\x35\x59\x4b\xd5\x40\x26\x23\xd0\x6d\x68\xed\xde\x7a\x37\xb8\x53\x88\xaa\x16\xb9\x53\x32\x4d\xc8\x96\x7d\xfb\x37\xac\xc3\x91\x1d\x8b\x53\x18\x10\x3e\x84\x21\xf9\x74\x98\x4f\xcb\x05\xcc\x2c\xfe\x7b\xd8\x4a\xb5\xff\xf9\xf2\x7a\x94\xbd\x8e\x9f\x3a\xd0\x23\x06\x3e\xf9\xfb\x43\xb0\xd7\xd8\xf3\x70\x0a\x7b\xe6\x3c\x89\x81\xb0\x1d\xe3\x31\x5b\xf1\x98\x9b\x3d\xf9\x2e\x45\x47\x85\x17\x88\xf7\xab\x54\xd1\x3b\x90\xb8\xc1\x96\x48\x21\xa0\x48\x52\xfa\x69\x47\xa8\xa7\xd6\x0b\x70\xd6\x3f\x2a\xcc\x14\xa0\x31\xe1\x82\x24\xb9\x1f\xa2\xd3\x95\x00\xa3\xbb\xb2\xd3\x95\x1c\x3b\x72\x5f\x21\x7a\xb6\x05\xcb\xa9\x22\x27\x85\x41\xa9\x4b\x5e\x5a\x90\x97\x45\x88\x4e\xc8\xbf\x73\x6d\x7e\x45\x8e\x44\xfa\x99\x5d\x8d\xc7\xb9\xee\x78\x1c\x44\xbf\x9d\x58\xc6\xf3\x62\x56\x01\xef\x0b\xd7\x9f\x28\xf2\x8e\xb0\x6f\x6b\x6f\x8b\xc7\x4f\x68\x0b\xd7\x9f\xb8\x3d\x64\xbc\xf0\x91\x2e\x4d\xa4\x36\x1b\x05\xfa\x0d\xbd\x93\x96\x71\x5a\x08\x9e\x93\xb0\xbb\x08\x75\x22\x70\x25\x36\x0b\xa7\x73\xdf\xe9\x79\xc6\x23\xe5\x7a\xef\x28\xda\x2c\x98\x3f\x92\x29\xb2\xf6\x6b\x33\x82\xd7\xbe\xa5\x77\x67\x93\xf9\x23\x35\x49\xea\x34\x11\x5d\x48\x65\xae\x3f\xff\x72\xf5\x14\x26\x93\x28\x0f\x8e\x51\x6c\x67\xd6\x7b\xda\x1d\x98\x89\x31\x79\xa3\x96\xac\x62\x9b\xf5\x46\x44\x89\xe4\xc2\x0c\xad\x25\x68\x9d\xed\x6a\xcd\x31\xab\x7d\xdb\x8f\x1f\xf3\x9d\x59\x7a\x8e\x6f\xc4\x6e\x51\xd4\xb2\xb9\x23\x39\xce\x8b\xad\xb7\x92\xf7\x39\x68\xb2\x4d\x97\x83\x4a\xde\xe7\xa0\xd9\x92\x5d\x0e\x2a\xb9\x75\xe0\x94\xe3\x11\xb4\x4c\x55\x08\x4e\x41\x96\x10\xa6\x0a\xc9\x31\x23\xf9\x73\x9d\xe6\x2a\x37\xba\x61\xa1\x64\x02\xca\x70\x70\x19\x03\x25\x99\xaa\xd7\x55\xe5\x60\x90\xf2\xf0\x35\x68\x5a\x38\x41\x02\xb1\xc9\x4a\x8d\x05\x6b\xe8\x10\xbb\xde\x20\xc1\xa8\x46\x86\x32\xb6\x3e\x4d\x98\xd8\x3a\xde\x2a\xb9\x2d\x8a\x4f\x6d\x2f\xd8\x67\x4f\xd2\x7f\x62\xfb\x3e\x48\x9c\xb4\x95\xd3\x50\x65\x2d\x7f\xbd\x38\xd0\x50\x29\x88\x7c\x33\xda\x30\x38\xf6\x30\xdb\xb9\xec\x3e\xce\xf1\x6d\x3e\x6d\x2a\xbf\x29\xbb\x8e\xc9\xfb\xb2\x9c\xd9\x05\x51\xe3\x4c\x1a\xac\xf3\xb9\x28\x59\x78\xe0\x67\xbc\x58\xb3\x47\xd6\xf5\x62\x7b\x14\xa9\x9b\xd1\x93\xe9\xaa\x66\xf2\x6c\xae\xea\xe9\x7d\x4b\xa2\xba\x4e\xb6\x15\xce\x96\xd0\x5d\x12\xf5\x0e\x37\x8e\xad\x73\x3e\xec\xb5\x0b\x22\x10\x86\xaf\x39\x28\x37\xb0\x5d\xcf\xd2\xb0\xf0\xf5\x21\x9f\x58\xcf\xfc\xa1\x9a\xf3\x36\xbd\x78\xaa\xee\x69\xad\x65\xe8\x8a\xdb\xe6\x8d\x36\x6a\xd9\x36\xbb\xd2\x33\xbc\x11\x1b\xbc\x45\xd1\xee\xcd\xae\x92\x97\xb7\x27\x54\xfb\x32\xfc\x3c\xbc\x76\x75\xee\x99\x46\x5c\x2e\xad\xb5\xb9\xac\xc7\xc4\xa5\xd3\x36\x87\xba\x66\xf5\x91\xb3\xbe\x73\x15\xcf\x3c\x55\x97\x31\x1c\x49\xba\x8a\x79\x18\xef\x27\x21\x32\xa9\xe6\xab\x18\xba\x0e\x5d\x76\xc2\xf2\x66\x2a\x7b\x77\x93\x78\x8e\x70\x6f\x75\xa8\xce\xbf\xc5\xb9\x64\x8b\xbc\xf4\x72\x62\x6e\xbc\xfa\x9e\x9d\x1e\x4f\xbf\x7b\x86\x88\xcf\x36\xee\xe1\xe5\x7c\x5b\xde\xb2\x2d\x8f\xf7\xce\x61\x08\x1b\xc0\xcb\x67\x7d\x13\xf5\xe2\xa1\xd0\xbb\x74\xb4\xb9\xaa\xeb\x92\x3d\xf0\x27\xec\x41\xe6\xdb\xa4\xbd\x0f\x9f\xb8\x6d\x3b\xd4\x44\xba\x68\xea\xa2\x7c\x3f\x5e\x1c\x0e\x04\x44\x64\xbf\x35\xf8\x3f\x00\x00\xff\xff\x3b\x63\x4d\x4d\x4d\x10\x00\x00")
+var _templatesServicePostgresTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\xdf\x6f\xdb\x36\x10\x7e\xcf\x5f\x71\xe0\x4b\x37\xc0\xf5\xdc\xac\x7d\x88\x30\x0c\x70\xec\xa4\x30\xb0\x64\x86\x9d\xba\xc0\x8c\x60\xa0\xa5\xb3\x43\x44\x26\x39\x92\x72\xeb\x1a\xfa\xdf\x07\xca\xfa\x41\x4a\xb2\x9c\xa4\x85\x1b\x24\xba\xbb\xef\x3e\x7e\xbc\x3b\x52\x3e\x1c\x20\xc2\x35\xe3\x08\x44\xa3\xda\xb1\x10\x09\xa4\xe9\x05\xc0\xe1\x02\x00\x80\x0c\xbf\xce\x1f\x70\x2b\x63\x6a\xf0\x56\xa8\x2d\x35\x0b\x54\x9a\x09\x4e\x20\x00\x72\x39\xf8\x30\x78\x3f\xb8\x7a\x3f\xb8\x22\xbd\xa3\xfb\x48\xf0\x88\x19\x26\xb8\x26\x41\x0e\x01\x40\xae\x63\xca\x9f\xef\xe8\xf7\x91\xe0\x1c\xc3\xd2\x0c\xe4\x96\x07\xc1\xcd\x7f\x09\x8d\xed\xdf\x4b\x38\x00\x99\xe1\x9a\x04\x40\x6a\xce\x90\xf6\x80\x10\x78\x84\xb4\x57\x60\x4e\x15\xdb\x51\x83\x67\x70\x0a\xaf\x0c\xc0\xa8\x04\x7d\x90\x39\xa7\x52\x3f\x09\x53\xa1\xdc\x67\x7f\x2c\x3b\x41\xcb\xa8\x8a\x96\xfd\x9f\x81\xe6\xd8\x64\x4a\x15\xdd\xa2\x41\xe5\x09\x31\x8c\x63\x11\x52\x83\xd1\xdc\x08\x45\x37\xe8\xd8\x00\xc8\xc3\x5e\x62\xa6\xeb\x7d\xb2\x5d\xa1\xca\x35\xb5\x1f\x32\xc6\x35\x4d\x62\x93\x59\x3f\x0c\x7c\x8b\x0e\x15\x93\xa6\xd8\x93\x32\x05\xe8\x63\x0e\xd0\xec\x07\xc2\x2f\x9f\xaf\x7f\x25\x79\x54\xb5\xfe\x31\x35\x74\x45\xf5\x29\x1e\x73\xa3\x18\xdf\x9c\xe2\x41\xa5\xec\x22\x92\xbb\x42\x94\xe7\x00\x4e\xb7\xd8\xa4\x30\xe1\xda\x50\x1e\x62\xb6\xf8\xb7\xd0\x88\x56\x7d\x73\xd9\xdf\xb2\x50\x89\x2e\x3a\x45\x1e\x08\x63\xaa\x35\xac\x85\x72\x98\x89\x08\x75\x93\x5a\xb3\x62\x5f\x4d\xae\x8b\x51\x59\x22\x9f\x95\x48\x24\x6c\xe9\xf7\x7f\xc3\x2a\x1d\xec\x68\x9c\x60\x0f\x58\x1f\xfb\xf0\xee\x30\xbe\x2e\x16\x30\xb2\xfc\xef\x70\x2b\xd4\xfe\xb7\x0f\x9f\x06\xd9\xbf\xf4\x5d\x0b\xfb\x24\x36\x6c\xf8\xcf\x9b\x68\xaf\x69\xac\xb1\x8b\x7b\x06\x2e\x63\x04\xba\xa3\x2c\xa6\x2b\x16\x33\xb3\x87\x1f\x82\xb7\xec\xf0\x94\x6a\xfd\x4d\xa8\xe8\x15\x4c\xfc\x64\x73\x54\x3b\x54\x20\x0b\x9c\x66\x86\x6a\x16\xd4\x12\x9c\xc5\x0f\x80\x8c\x14\x52\x83\xc0\x38\xc8\x23\x0e\xe8\x64\xc5\xd1\x68\x3f\xe6\x58\x71\x6d\xe2\xd8\x96\xfb\x86\xd1\xc2\x6e\x98\x6d\xf7\x65\x3e\x6a\x7a\x85\x2f\x3c\x36\x28\xbb\x93\xe7\x65\x9c\xcb\xfc\x1d\x4b\x99\xa1\x6d\x7a\x84\xb5\x12\x5b\x30\x4f\x4c\xc3\x8a\x86\xcf\x89\xec\x81\x42\x19\xd3\x90\xf1\x0d\x98\x27\x2c\x2b\xbf\x29\xe5\x3c\x5f\x7a\x2b\xad\xbf\x98\x36\x7f\x0c\xbf\xce\x83\xe0\x66\x74\x19\x04\x47\xdf\x20\x98\x44\x7f\x76\x70\x5a\x4c\x47\xa5\xa0\xa7\xd2\x9d\xde\x40\x78\x45\xda\x97\xb5\x9d\xe5\x53\xdf\xe8\x06\xaf\x2f\x1a\x55\x36\xaf\xda\x19\x9d\xde\x1f\x6b\x95\x42\x9b\x8d\x42\xdd\xc5\x22\xaf\xe9\xa4\xc8\xd3\x60\xb0\x90\x61\x7b\x6d\x54\x42\x2c\xa6\x23\x2b\xfe\xa9\x34\x47\xed\x5b\x91\x47\x2c\x52\x3e\x7a\x33\x10\x46\x93\xf1\x0c\xae\x63\x11\x3e\x93\x5e\x93\x46\x2e\x41\x6e\xf0\x0f\xbf\xbf\x13\x23\x13\xaf\x88\xc8\x54\x28\xf3\xe9\xe3\xef\x97\x0f\xa1\x1c\x46\xc7\xe4\x40\xb2\x8e\xa9\xce\xde\xcf\x68\x86\xc6\x96\xf8\xb2\x1a\xd7\xa4\x07\xe4\x86\x47\x52\x30\x6e\xfa\x36\x12\xb5\xce\xce\x70\x67\x41\x0e\xb6\xfd\xf5\x6d\xd8\x59\x64\x0d\xf8\x86\xef\xa6\xf9\x5e\xba\x27\xa5\x07\x9e\xdf\x09\x4a\xfb\x29\x00\x77\x0a\xb6\x01\x94\xf6\x53\x00\x6e\x49\xb6\x01\x94\x76\x48\x6b\xdb\x31\x43\x2d\x12\x15\xa2\xb7\x21\x73\x0c\x13\xc5\xcc\x3e\x3b\x7c\xce\x55\x9a\xef\x5c\xb0\xb3\xd2\x2b\x21\x51\x19\xe6\x61\xdb\x0f\xc9\x5c\x6b\x55\x55\x34\x06\x14\x57\xcd\x0a\xa9\xce\x68\xc2\xad\xa3\x45\x5d\x3a\x3e\x60\x97\x3e\x91\x53\x25\x8c\x08\x45\x6c\x49\x9a\x50\xda\x1a\xb9\x55\x62\x9b\x6f\x3e\xb1\xb5\x60\x9f\x3d\x88\xfa\x13\x5b\xf7\x13\xe9\xc9\x56\x74\x43\xa9\xda\xf1\xf3\xe8\x51\x5b\xc8\x70\x12\xd5\xc3\x88\x13\x50\xfc\x56\xed\xdc\x71\x38\x9e\x53\x77\x36\x9e\x07\xc1\xf8\xda\x75\x7e\x91\xba\x5e\xc8\xeb\x54\xce\x52\x4d\x22\xe7\x06\x3e\xb1\x42\x2c\xab\x63\xd4\xf5\x07\x67\xcd\xb5\x61\x0d\xe9\x19\x47\x57\x20\x80\xc7\x4e\xb9\xca\x9e\x3c\xab\x55\xd5\xbd\x95\xdf\x18\x63\xb4\xa3\x75\x2a\x62\x16\xee\xbd\x3b\xba\xe3\xf5\x45\x46\xd4\xe0\x2c\x3b\x09\xb1\xdb\xf5\xb4\xf2\x6d\x57\xf8\x72\xe1\x0d\xa3\xaf\x11\xa9\xdd\xe2\xbc\xd8\xc2\x92\x75\xdf\xc9\xb8\x49\x84\xdc\xb0\x35\x43\xd5\xdc\xbf\x6a\x21\x2e\x25\x2b\xdd\xbd\x38\xce\x0b\x48\x1b\xa6\xb9\xa1\xe1\xf3\x7d\x3e\x37\x1e\x1b\x79\xef\xcb\x89\xf3\x33\x99\xdc\xe9\xd8\xcc\xe1\x5f\x84\xab\x8c\x79\xb0\x6f\x26\x8d\xf0\x82\xcc\xab\xa5\x29\x1f\x42\xda\xb1\x8e\x16\xc2\x4e\xef\x35\xd8\x3a\xb6\x3a\xd5\x1b\xbe\x61\x1c\xdd\x16\x25\x2d\xf6\xe2\x05\x3b\x00\x72\xd5\xff\xd8\xff\xe4\xfb\xdc\x51\x6d\x50\xf9\x67\xc1\xcf\xec\x8c\x7b\x6a\xd4\x17\x5a\xe5\xf2\x0f\xaf\xe6\x89\xe5\x87\x55\x2f\x1e\xa5\x6f\xf1\xac\xe6\xea\xcf\x67\xcf\x92\xac\x62\x16\xc6\xfb\x61\x18\xa2\xd6\x6c\x15\x63\xdb\xd5\xdb\xce\xb3\x63\xa7\x15\x93\x62\x23\x6b\x40\x8b\xe9\xc8\x3b\x58\x1a\x2f\xf3\xae\x31\x53\xa1\x63\x4a\xd5\x4a\xf1\xec\xac\xaa\xf9\xb7\x0f\x18\xa8\x4d\x18\x77\x9c\x43\xd0\xde\x45\x15\x12\x00\xb9\xa5\x5b\x16\xef\xbd\xab\xe7\x55\xff\xa3\x2f\x43\xc9\xa4\x91\x0f\x80\xd4\x5e\x3d\x9b\x55\xd5\xf6\x05\xce\x0b\x0b\xac\x16\xe5\x7c\x55\xe2\x8b\xec\xc8\x7d\x51\xfc\x4c\x2f\x0e\x07\x40\x1e\x41\x9a\x5e\xfc\x3f\x00\xf7\x6c\xc7\xdb\xa9\x12\x00\x00")
 
 func templatesServicePostgresTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -130,7 +173,27 @@ func templatesServicePostgresTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/service/postgres.tmpl", size: 4173, mode: os.FileMode(420), modTime: time.Unix(1465598780, 0)}
+	info := bindataFileInfo{name: "templates/service/postgres.tmpl", size: 4777, mode: os.FileMode(436), modTime: time.Unix(1792429462, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServiceRabbitmqTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x57\x5b\x6f\xda\x30\x14\x7e\xef\xaf\xb0\xfc\xcc\x2a\x7a\xd9\x2d\x9a\x26\x05\x4a\xbb\x48\xc0\x28\x81\x4e\x5a\x55\x55\x26\x31\x95\xd5\xc4\x76\x6d\x87\xaa\x43\xfc\xf7\xd9\xb9\x80\x9d\x04\xda\x4e\x93\x4a\x8b\xce\xed\x3b\x3e\x97\xcf\xee\x7a\x0d\x62\xbc\x24\x14\x03\x28\xb1\x58\x91\x08\x43\xb0\xd9\x1c\x01\xb0\xd6\x1f\x00\xa0\xff\x2b\x9c\xe1\x94\x27\x48\xe1\x4b\x26\x52\xa4\x6e\xb0\x90\x84\x51\x08\x3c\x00\x4f\xbb\x27\xdd\x0f\xdd\xaf\xfa\x07\x76\x0a\xf3\x3e\xa3\x31\x51\x5a\x2f\xa1\x57\x86\xd0\xd2\x51\x96\x28\xe2\xff\x36\x22\x00\x2f\xa9\xe7\x0d\x9e\x32\x94\x18\x93\x5b\x23\x99\xe2\xa5\xfe\xba\xb5\x02\x9b\x0e\x80\x4a\x64\x3a\x93\x3b\xfd\xbd\x0a\x32\x11\x64\xa5\xb3\x78\x25\x48\x65\xe5\x06\xc9\x63\x94\xa1\xe0\x04\x09\x94\x62\xa5\xcf\x61\xe7\x18\x50\xa9\x10\x8d\xf0\xec\x85\x63\x4b\xae\x35\xa5\x04\x86\x4a\x10\xfa\x00\x3b\x3b\xcd\x05\x5e\x22\x9d\xb4\x51\xa6\x4f\xc7\xea\xec\x38\x25\x91\x60\xae\x85\x8c\x04\xe1\xa6\x22\xc6\xaa\x02\x01\x4a\xc7\x04\x4b\x26\xc0\x42\xb0\x47\x2c\x00\x65\x31\x96\xb0\xf4\xdb\x9d\xd9\x2a\x5c\x2d\x1f\x70\x30\x21\xa3\x5d\xea\xe2\xe0\x7d\xb9\x18\x83\x69\x46\x01\x02\x51\x92\x49\x5d\x0b\xc0\x96\x65\x2e\x12\x20\x7d\x08\xa9\xff\xac\x10\x49\xd0\x82\x24\x44\xbd\x80\x3f\x8c\xb6\x25\x38\x41\x52\x3e\x33\x11\xbf\x23\x43\x37\x89\x5e\x71\x7e\x5e\xc5\x69\x22\xec\xda\xfe\xb6\x96\x38\x05\xef\x0b\xac\x9d\x01\xa1\x80\x17\x71\x80\xcc\x16\x14\x2b\xb9\xa7\x8d\x8d\xa2\xf9\x49\xc2\x9e\x71\x7c\x83\x92\x0c\x17\xb3\x56\x4c\x55\xa7\xb2\x05\x77\x8d\x94\xc3\x12\xa2\x35\xe5\x21\x91\xea\x9b\xde\x2a\x3d\xbf\xfd\x53\xcf\x2b\x6c\x3d\x2f\x88\xbf\x1f\x38\xc6\xcd\xa4\xbf\x4d\x7c\x1f\xdc\xfe\x42\x81\x77\xc0\xee\xe6\xe7\x50\xd7\x4c\x3e\xf5\x82\x36\xf2\x9a\x6b\x42\xa1\x7a\xd3\xfe\x69\x7a\x11\xe7\x6f\x18\x9b\xac\x82\x68\x80\x57\x34\xf5\xfe\x4d\x3e\x3b\xfe\x72\xfc\xe9\x40\x2f\xa6\x68\xb1\x20\x6a\x74\x0d\x56\x25\x44\x13\x9b\x47\xed\xb8\xbb\xfa\xeb\x02\x9a\xe2\x1f\x6e\x79\x6b\xe4\x3e\x89\x85\x1b\xbd\x65\x56\xfa\xc1\xc5\x14\xf4\x12\x16\x3d\xda\x08\xb5\xe3\x57\xd1\x1d\x72\xfc\x99\x29\x9e\x39\xb3\x0b\xfd\xf4\x89\x0f\x68\xcc\x19\xa1\xaa\x60\xdf\x7c\x1b\x76\x44\x1c\xe2\x04\x47\xaa\x58\x8e\xae\xde\x8c\x52\x7c\x85\x95\xaf\x4a\x71\xd1\x2f\xb3\x35\x76\x34\x99\x93\x73\xfe\xd9\x9d\x71\x40\x57\x85\xb5\x3d\x3f\x0e\x66\x49\xf5\x5b\x7d\xbb\xbb\x4d\x4d\x6d\xee\x5b\xbd\x71\x77\x6a\x30\xc5\x92\x65\x22\xc2\x4e\x15\x42\x1c\x65\x42\x33\xe1\x95\x60\x19\x7f\xad\xbd\xae\xb1\xd5\x82\x89\x60\x1c\x0b\x45\xb0\xcb\x0e\x5a\x93\x9b\xd6\x5a\x29\xf2\x51\x4b\x9f\x40\x75\x35\x77\x6c\x0f\x07\x24\xa0\x0f\x02\xcb\x9c\xa1\x2c\x1b\x60\xce\x1b\x70\x8d\xaa\x58\xc4\x12\x13\x53\x45\xdc\xb4\xe1\x52\xb0\x74\xc2\x44\x3e\xf1\x1f\x3f\x7d\x3e\x31\xb2\x19\xab\x4b\xcc\xb0\x05\xdc\x29\x5b\x35\x82\x76\xd1\xdf\x0e\x75\x7e\x7e\xe6\x22\x95\x82\x57\x80\x2c\x9c\x3b\xa7\x06\xda\x28\x88\xeb\x6e\xd0\x72\xd8\x34\x76\xa8\x1c\xc5\xfd\x1d\xf4\x53\xa4\x6f\xbb\xd1\xb5\xe7\x55\x53\xfb\x96\xfe\xf9\x99\x62\x23\x42\x99\x28\x99\x67\xce\x1f\x04\x8a\x4d\x54\x73\x5f\x38\x49\x17\x61\xc7\xdb\xd1\x2e\x33\xcf\xc1\x43\x85\xa2\xc7\x71\x31\xd5\x8e\xd3\x05\xe6\x09\x7b\x49\x31\x55\x23\x16\x5b\xcb\x17\x2c\x8b\x0d\xab\x9e\x0a\xa6\x98\xc3\x79\x38\x1b\x4c\xef\x47\xf3\xe1\x2c\xb8\x2f\x64\x61\x30\xbe\x1a\x0e\xee\x83\x71\x38\xf3\xc7\xfd\x81\xf3\xb4\x2a\xf7\xe6\x41\xbf\x02\xab\x42\x4c\xfd\x5e\x2f\x98\x8d\xae\x61\x8b\x8d\x45\xad\xbb\xaa\x57\xaf\x42\x37\xe8\x0f\x26\x55\xfd\x61\xb5\xf5\x71\x14\x35\xc7\x49\xb6\x48\x48\x94\xbc\xf8\x91\x5e\x43\x49\x16\x89\x71\xcd\x2f\xdc\xfd\x2b\x50\x7f\x0a\xba\x4b\x68\x78\xc6\xf5\xcd\xef\xad\x20\x96\x07\x6a\x59\x9f\x6f\xdb\xa8\xba\x6f\x3b\x36\xa4\x7b\x15\x9b\x47\x68\x43\x09\x0b\xc6\x73\x63\xdf\xbe\xc2\xa5\xff\x09\xb4\xf8\xd8\xdb\x54\xab\xbb\xe1\xd4\x56\x12\x71\xc9\xb8\xc9\xc0\x1d\xf7\x2d\x78\x80\x65\x4b\xe0\xe6\x86\x1e\x55\xbf\x37\x47\xeb\x35\xc0\x34\x36\xff\x88\xfc\x05\xf8\x53\xe7\xce\xa0\x0c\x00\x00")
+
+func templatesServiceRabbitmqTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServiceRabbitmqTmpl,
+		"templates/service/rabbitmq.tmpl",
+	)
+}
+
+func templatesServiceRabbitmqTmpl() (*asset, error) {
+	bytes, err := templatesServiceRabbitmqTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/service/rabbitmq.tmpl", size: 3232, mode: os.FileMode(420), modTime: time.Unix(1792426275, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -288,8 +351,11 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"templates/app.tmpl": templatesAppTmpl,
+	"templates/service/elasticsearch.tmpl": templatesServiceElasticsearchTmpl,
+	"templates/service/memcached.tmpl": templatesServiceMemcachedTmpl,
 	"templates/service/mysql.tmpl": templatesServiceMysqlTmpl,
 	"templates/service/postgres.tmpl": templatesServicePostgresTmpl,
+	"templates/service/rabbitmq.tmpl": templatesServiceRabbitmqTmpl,
 	"templates/service/redis.tmpl": templatesServiceRedisTmpl,
 	"templates/service/s3.tmpl": templatesServiceS3Tmpl,
 	"templates/service/sns.tmpl": templatesServiceSnsTmpl,
@@ -340,8 +406,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
 		"app.tmpl": &bintree{templatesAppTmpl, map[string]*bintree{}},
 		"service": &bintree{nil, map[string]*bintree{
+			"elasticsearch.tmpl": &bintree{templatesServiceElasticsearchTmpl, map[string]*bintree{}},
+			"memcached.tmpl": &bintree{templatesServiceMemcachedTmpl, map[string]*bintree{}},
 			"mysql.tmpl": &bintree{templatesServiceMysqlTmpl, map[string]*bintree{}},
 			"postgres.tmpl": &bintree{templatesServicePostgresTmpl, map[string]*bintree{}},
+			"rabbitmq.tmpl": &bintree{templatesServiceRabbitmqTmpl, map[string]*bintree{}},
 			"redis.tmpl": &bintree{templatesServiceRedisTmpl, map[string]*bintree{}},
 			"s3.tmpl": &bintree{templatesServiceS3Tmpl, map[string]*bintree{}},
 			"sns.tmpl": &bintree{templatesServiceSnsTmpl, map[string]*bintree{}},
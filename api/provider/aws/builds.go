@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +13,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -59,7 +61,7 @@ func (p *AWSProvider) BuildCopy(srcApp, id, destApp string) (*structs.Build, err
 	}
 
 	for name, entry := range m {
-		entry.Build = ""
+		entry.Build = nil
 		entry.Image = registryTag(srcA, name, srcB.Id)
 		m[name] = entry
 	}
@@ -114,10 +116,22 @@ func (p *AWSProvider) BuildCreateIndex(app string, index structs.Index, manifest
 		return nil, err
 	}
 
-	return p.BuildCreateTar(app, bytes.NewReader(tgz), manifest, description, cache)
+	b, err := p.BuildCreateTar(app, bytes.NewReader(tgz), manifest, description, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	// the build is already running, a missing record only makes its blobs collectable early
+	if err := p.indexRecord(app, b.Id, index, dir); err != nil {
+		fmt.Printf("aws BuildCreateIndex indexRecord app=%s build=%s err=%s\n", app, b.Id, err)
+	}
+
+	return b, nil
 }
 
-func (p *AWSProvider) BuildCreateRepo(app, url, manifest, description string, cache bool) (*structs.Build, error) {
+// BuildCreateRepo builds from a git repository. ref optionally selects a branch, tag or
+// commit to check out and dir a subdirectory of the repository to build from.
+func (p *AWSProvider) BuildCreateRepo(app, url, ref, dir, manifest, description string, cache bool) (*structs.Build, error) {
 	a, err := p.AppGet(app)
 	if err != nil {
 		return nil, err
@@ -138,6 +152,18 @@ func (p *AWSProvider) BuildCreateRepo(app, url, manifest, description string, ca
 		return b, err
 	}
 
+	creds, err := p.BuildCredentialsGet(a.Name)
+	if err != nil {
+		return b, err
+	}
+
+	env = append(env,
+		fmt.Sprintf("GIT_REF=%s", ref),
+		fmt.Sprintf("GIT_SSH_KEY=%s", creds.SSHKey),
+		fmt.Sprintf("GIT_TOKEN=%s", creds.Token),
+		fmt.Sprintf("SOURCE_DIR=%s", dir),
+	)
+
 	err = p.buildRun(a, b, args, env, nil)
 
 	// build create is now complete or failed
@@ -254,6 +280,9 @@ func (p *AWSProvider) BuildDelete(app, id string) (*structs.Build, error) {
 		TableName: aws.String(buildsTable(app)),
 	})
 
+	// release the index blobs referenced by this build
+	p.s3Delete(os.Getenv("SETTINGS_BUCKET"), indexRefsKey(app, id))
+
 	// delete ECR images
 	err = p.deleteImages(a, b)
 	if err != nil {
@@ -395,6 +424,31 @@ func (p *AWSProvider) BuildSave(b *structs.Build) error {
 		TableName: aws.String(buildsTable(b.App)),
 	}
 
+	if b.Author != "" {
+		req.Item["author"] = &dynamodb.AttributeValue{S: aws.String(b.Author)}
+	}
+
+	if b.Branch != "" {
+		req.Item["branch"] = &dynamodb.AttributeValue{S: aws.String(b.Branch)}
+	}
+
+	if b.BuilderVersion != "" {
+		req.Item["builder-version"] = &dynamodb.AttributeValue{S: aws.String(b.BuilderVersion)}
+	}
+
+	if b.Commit != "" {
+		req.Item["commit"] = &dynamodb.AttributeValue{S: aws.String(b.Commit)}
+	}
+
+	if len(b.Images) > 0 {
+		data, err := json.Marshal(b.Images)
+		if err != nil {
+			return err
+		}
+
+		req.Item["images"] = &dynamodb.AttributeValue{S: aws.String(string(data))}
+	}
+
 	if b.Description != "" {
 		req.Item["description"] = &dynamodb.AttributeValue{S: aws.String(b.Description)}
 	}
@@ -428,6 +482,72 @@ func (p *AWSProvider) BuildSave(b *structs.Build) error {
 	return err
 }
 
+// BuildCredentialsGet returns the credentials builds use to clone an app's
+// private git repositories
+func (p *AWSProvider) BuildCredentialsGet(app string) (*structs.BuildCredentials, error) {
+	a, err := p.AppGet(app)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := p.settingsGet(a, "build-credentials")
+	if err != nil {
+		return nil, err
+	}
+
+	creds := &structs.BuildCredentials{}
+
+	if len(data) > 0 {
+		err = json.Unmarshal(data, creds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return creds, nil
+}
+
+// BuildCredentialsSave encrypts and stores the git credentials for an app
+func (p *AWSProvider) BuildCredentialsSave(app string, creds *structs.BuildCredentials) error {
+	a, err := p.AppGet(app)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+
+	return p.settingsPut(a, "build-credentials", data)
+}
+
+// BuildSecretsGet returns the build secrets for an app. They are stored encrypted
+// in the app settings bucket alongside the release environment
+func (p *AWSProvider) BuildSecretsGet(app string) (structs.Environment, error) {
+	a, err := p.AppGet(app)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := p.settingsGet(a, "build-secrets")
+	if err != nil {
+		return nil, err
+	}
+
+	return structs.LoadEnvironment(data), nil
+}
+
+// BuildSecretsSave encrypts and stores the build secrets for an app
+func (p *AWSProvider) BuildSecretsSave(app string, env structs.Environment) error {
+	a, err := p.AppGet(app)
+	if err != nil {
+		return err
+	}
+
+	return p.settingsPut(a, "build-secrets", []byte(env.Raw()))
+}
+
 func (p *AWSProvider) buildArgs(a *structs.App, b *structs.Build, source string) []string {
 	return []string{
 		"run",
@@ -436,6 +556,7 @@ func (p *AWSProvider) buildArgs(a *structs.App, b *structs.Build, source string)
 		"-v", "/var/run/docker.sock:/var/run/docker.sock",
 		"-e", "APP",
 		"-e", "BUILD",
+		"-e", "BUILDER_VERSION",
 		"-e", "DOCKER_AUTH",
 		"-e", "RACK_HOST",
 		"-e", "RACK_PASSWORD",
@@ -446,6 +567,12 @@ func (p *AWSProvider) buildArgs(a *structs.App, b *structs.Build, source string)
 		"-e", "MANIFEST_PATH",
 		"-e", "REPOSITORY",
 		"-e", "NO_CACHE",
+		"-e", "BUILD_SECRETS",
+		"-e", "CACHE_FROM",
+		"-e", "GIT_REF",
+		"-e", "GIT_SSH_KEY",
+		"-e", "GIT_TOKEN",
+		"-e", "SOURCE_DIR",
 		os.Getenv("DOCKER_IMAGE_API"),
 		"build",
 		source,
@@ -510,6 +637,7 @@ func (p *AWSProvider) buildEnv(a *structs.App, b *structs.Build, manifest_path s
 	env := []string{
 		fmt.Sprintf("APP=%s", a.Name),
 		fmt.Sprintf("BUILD=%s", b.Id),
+		fmt.Sprintf("BUILDER_VERSION=%s", os.Getenv("RELEASE")),
 		fmt.Sprintf("MANIFEST_PATH=%s", manifest_path),
 		fmt.Sprintf("DOCKER_AUTH=%s", dockercfg),
 		fmt.Sprintf("RACK_HOST=%s", host),
@@ -523,11 +651,58 @@ func (p *AWSProvider) buildEnv(a *structs.App, b *structs.Build, manifest_path s
 
 	if cache == false {
 		env = append(env, "NO_CACHE=true")
+	} else if images := p.buildCacheFrom(a); len(images) > 0 {
+		env = append(env, fmt.Sprintf("CACHE_FROM=%s", strings.Join(images, ",")))
+	}
+
+	// build secrets are handed to the builder through its environment so they
+	// never show up in the build command line or logs
+	secrets, err := p.BuildSecretsGet(a.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(secrets) > 0 {
+		env = append(env, fmt.Sprintf("BUILD_SECRETS=%s", secrets.Raw()))
 	}
 
 	return env, nil
 }
 
+// buildCacheFrom returns the images a build can use as cache sources: the images
+// built for the app's current release and the optional rack-wide shared cache image.
+// Lookup failures only mean a colder build so they are logged and skipped.
+func (p *AWSProvider) buildCacheFrom(a *structs.App) []string {
+	images := []string{}
+
+	if a.Release != "" {
+		r, err := p.ReleaseGet(a.Name, a.Release)
+		if err != nil {
+			fmt.Printf("aws buildCacheFrom ReleaseGet app=%s release=%s err=%s\n", a.Name, a.Release, err)
+		} else if r.Build != "" {
+			var m manifest.Manifest
+
+			if err := yaml.Unmarshal([]byte(r.Manifest), &m); err != nil {
+				fmt.Printf("aws buildCacheFrom yaml.Unmarshal app=%s release=%s err=%s\n", a.Name, a.Release, err)
+			}
+
+			for name, entry := range m {
+				if entry.Build != nil {
+					images = append(images, registryTag(a, name, r.Build))
+				}
+			}
+		}
+	}
+
+	sort.Strings(images)
+
+	if shared := os.Getenv("BUILD_CACHE_IMAGE"); shared != "" {
+		images = append(images, shared)
+	}
+
+	return images
+}
+
 // buildFromItem populates a Build struct from a DynamoDB Item. It also populates build.Logs
 // from an S3 object if a bucket is passed in and a builds/B1234.log object exists.
 func (p *AWSProvider) buildFromItem(item map[string]*dynamodb.AttributeValue, bucket string) *structs.Build {
@@ -562,16 +737,29 @@ func (p *AWSProvider) buildFromItem(item map[string]*dynamodb.AttributeValue, bu
 		}
 	}
 
+	var images map[string]structs.BuildImage
+
+	if data := coalesce(item["images"], ""); data != "" {
+		if err := json.Unmarshal([]byte(data), &images); err != nil {
+			fmt.Printf("aws buildFromItem json.Unmarshal images err=%s\n", err)
+		}
+	}
+
 	return &structs.Build{
-		Id:          id,
-		App:         coalesce(item["app"], ""),
-		Description: coalesce(item["description"], ""),
-		Logs:        logs,
-		Manifest:    coalesce(item["manifest"], ""),
-		Release:     coalesce(item["release"], ""),
-		Status:      coalesce(item["status"], ""),
-		Started:     started,
-		Ended:       ended,
+		Id:             id,
+		App:            coalesce(item["app"], ""),
+		Author:         coalesce(item["author"], ""),
+		Branch:         coalesce(item["branch"], ""),
+		BuilderVersion: coalesce(item["builder-version"], ""),
+		Commit:         coalesce(item["commit"], ""),
+		Description:    coalesce(item["description"], ""),
+		Images:         images,
+		Logs:           logs,
+		Manifest:       coalesce(item["manifest"], ""),
+		Release:        coalesce(item["release"], ""),
+		Status:         coalesce(item["status"], ""),
+		Started:        started,
+		Ended:          ended,
 	}
 }
 
@@ -16,6 +16,7 @@ func init() {
 	os.Setenv("RACK", "convox")
 	os.Setenv("DYNAMO_BUILDS", "convox-builds")
 	os.Setenv("DYNAMO_RELEASES", "convox-releases")
+	os.Setenv("SETTINGS_BUCKET", "convox-settings")
 }
 
 func TestBuildGet(t *testing.T) {
@@ -60,6 +61,7 @@ func TestBuildDelete(t *testing.T) {
 
 		releasesBuild2BatchWriteItemCycle,
 		build2DeleteItemCycle,
+		build2DeleteIndexRefsCycle,
 
 		build2BatchDeleteImageCycle,
 	)
@@ -351,6 +353,18 @@ var build2BatchDeleteImageCycle = awsutil.Cycle{
 	},
 }
 
+var build2DeleteIndexRefsCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings/index-refs/httpd/BNOARQMVHUO.json",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 204,
+		Body:       ``,
+	},
+}
+
 var build2GetItemCycle = awsutil.Cycle{
 	Request: awsutil.Request{
 		RequestURI: "/",
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/convox/rack/api/crypt"
+	"github.com/convox/rack/api/structs"
+)
+
+// externalCredentials are the credentials of an external service, kept out of its stack
+type externalCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// createExternal records a resource outside the rack as a service. The stack only holds
+// the URL, credentials are stored encrypted in the rack settings.
+func (p *AWSProvider) createExternal(s *structs.Service) (*cloudformation.CreateStackInput, error) {
+	u, err := url.Parse(s.Parameters["Url"])
+	if err != nil {
+		return nil, err
+	}
+
+	if u.User != nil {
+		return nil, fmt.Errorf("Pass credentials with --username and --password instead of in the url")
+	}
+
+	creds := externalCredentials{
+		Username: s.Parameters["Username"],
+		Password: s.Parameters["Password"],
+	}
+
+	delete(s.Parameters, "Username")
+	delete(s.Parameters, "Password")
+
+	if creds.Password != "" && creds.Username == "" {
+		return nil, fmt.Errorf("--password needs a --username")
+	}
+
+	if creds.Username != "" {
+		if err := p.externalCredentialsSave(s.Name, creds); err != nil {
+			return nil, err
+		}
+	}
+
+	formation, err := serviceFormation(s.Type, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &cloudformation.CreateStackInput{
+		StackName:    aws.String(serviceStackName(s)),
+		TemplateBody: aws.String(formation),
+	}
+
+	return req, nil
+}
+
+// externalURL returns the URL of an external service with its credentials
+func (p *AWSProvider) externalURL(s *structs.Service) (string, error) {
+	u, err := url.Parse(s.Parameters["Url"])
+	if err != nil {
+		return "", err
+	}
+
+	creds, err := p.externalCredentials(s.Name)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case creds == nil:
+	case creds.Password != "":
+		u.User = url.UserPassword(creds.Username, creds.Password)
+	default:
+		u.User = url.User(creds.Username)
+	}
+
+	return u.String(), nil
+}
+
+func (p *AWSProvider) externalCredentials(name string) (*externalCredentials, error) {
+	data, err := p.s3Get(os.Getenv("SETTINGS_BUCKET"), externalCredentialsKey(name))
+	if aerr, ok := err.(awserr.RequestFailure); ok && aerr.StatusCode() == 404 {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if key := os.Getenv("ENCRYPTION_KEY"); key != "" {
+		cr := crypt.New(os.Getenv("AWS_REGION"), os.Getenv("AWS_ACCESS"), os.Getenv("AWS_SECRET"))
+
+		data, err = cr.Decrypt(key, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var creds externalCredentials
+
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, err
+	}
+
+	return &creds, nil
+}
+
+func (p *AWSProvider) externalCredentialsSave(name string, creds externalCredentials) error {
+	data, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+
+	if key := os.Getenv("ENCRYPTION_KEY"); key != "" {
+		cr := crypt.New(os.Getenv("AWS_REGION"), os.Getenv("AWS_ACCESS"), os.Getenv("AWS_SECRET"))
+
+		data, err = cr.Encrypt(key, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.s3Put(os.Getenv("SETTINGS_BUCKET"), externalCredentialsKey(name), data, false)
+}
+
+func externalCredentialsKey(name string) string {
+	return fmt.Sprintf("services/%s/credentials", name)
+}
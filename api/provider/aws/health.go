@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/convox/rack/api/structs"
+)
+
+// databases are unhealthy with less than this share of their allocated storage free
+const serviceStorageFreeMinimum = 0.1
+
+// service stacks output the endpoints they listen on as Port<port>TcpAddr
+var serviceEndpointOutput = regexp.MustCompile(`^Port(\d+)TcpAddr$`)
+
+// ServiceHealthCheck checks that a service is reachable and collects its basic metrics,
+// saving the result as its latest health
+func (p *AWSProvider) ServiceHealthCheck(name string) (*structs.ServiceHealth, error) {
+	s, err := p.ServiceGet(name)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &structs.ServiceHealth{
+		Checked: time.Now().UTC(),
+		Metrics: map[string]float64{},
+	}
+
+	problems := serviceUnreachable(s)
+
+	switch s.Type {
+	case "mysql", "postgres":
+		pp, err := p.databaseHealth(s, h)
+		if err != nil {
+			return nil, err
+		}
+
+		problems = append(problems, pp...)
+	case "sqs":
+		if depth, ok := p.serviceMetric("AWS/SQS", "ApproximateNumberOfMessagesVisible", "Maximum", "QueueName", s.Outputs["Name"]); ok {
+			h.Metrics["queue-depth"] = depth
+		}
+	}
+
+	h.Status = "healthy"
+
+	if len(problems) > 0 {
+		h.Status = "unhealthy"
+		h.Reason = strings.Join(problems, ", ")
+	}
+
+	data, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.s3Put(os.Getenv("SETTINGS_BUCKET"), serviceHealthKey(name), data, false); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// ServiceHealthGet returns the latest health of a service, or nil if it was never checked
+func (p *AWSProvider) ServiceHealthGet(name string) (*structs.ServiceHealth, error) {
+	data, err := p.s3Get(os.Getenv("SETTINGS_BUCKET"), serviceHealthKey(name))
+	if aerr, ok := err.(awserr.RequestFailure); ok && aerr.StatusCode() == 404 {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var h structs.ServiceHealth
+
+	if err := json.Unmarshal(data, &h); err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+// databaseHealth collects the connections and free storage of a database
+func (p *AWSProvider) databaseHealth(s *structs.Service, h *structs.ServiceHealth) ([]string, error) {
+	res, err := p.cloudformation().DescribeStackResource(&cloudformation.DescribeStackResourceInput{
+		LogicalResourceId: aws.String("Instance"),
+		StackName:         aws.String(serviceStackName(s)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instance := *res.StackResourceDetail.PhysicalResourceId
+
+	if connections, ok := p.serviceMetric("AWS/RDS", "DatabaseConnections", "Average", "DBInstanceIdentifier", instance); ok {
+		h.Metrics["connections"] = connections
+	}
+
+	free, ok := p.serviceMetric("AWS/RDS", "FreeStorageSpace", "Minimum", "DBInstanceIdentifier", instance)
+	if !ok {
+		return nil, nil
+	}
+
+	// storage is allocated in GB
+	h.Metrics["storage-free"] = math.Floor(free/(1024*1024*1024)*10) / 10
+
+	allocated, err := strconv.ParseFloat(s.Parameters["AllocatedStorage"], 64)
+	if err != nil || allocated == 0 {
+		return nil, nil
+	}
+
+	if h.Metrics["storage-free"] < allocated*serviceStorageFreeMinimum {
+		return []string{fmt.Sprintf("%.1fGB of %.0fGB storage free", h.Metrics["storage-free"], allocated)}, nil
+	}
+
+	return nil, nil
+}
+
+// serviceMetric returns the latest value of a metric of a service resource over the last
+// 10 minutes, which is missing for resources that have not reported it yet
+func (p *AWSProvider) serviceMetric(namespace, metric, statistic, dimension, value string) (float64, bool) {
+	res, err := p.cloudwatch().GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+		Dimensions: []*cloudwatch.Dimension{
+			&cloudwatch.Dimension{Name: aws.String(dimension), Value: aws.String(value)},
+		},
+		EndTime:    aws.Time(time.Now()),
+		MetricName: aws.String(metric),
+		Namespace:  aws.String(namespace),
+		Period:     aws.Int64(5 * 60), // seconds
+		StartTime:  aws.Time(time.Now().Add(time.Duration(-10) * time.Minute)),
+		Statistics: []*string{aws.String(statistic)},
+	})
+	if err != nil || len(res.Datapoints) == 0 {
+		return 0, false
+	}
+
+	dp := res.Datapoints[0]
+
+	for _, d := range res.Datapoints {
+		if d.Timestamp.After(*dp.Timestamp) {
+			dp = d
+		}
+	}
+
+	switch statistic {
+	case "Average":
+		return aws.Float64Value(dp.Average), true
+	case "Minimum":
+		return aws.Float64Value(dp.Minimum), true
+	case "Maximum":
+		return aws.Float64Value(dp.Maximum), true
+	}
+
+	return 0, false
+}
+
+// serviceUnreachable returns the endpoints of a service that do not accept connections
+func serviceUnreachable(s *structs.Service) []string {
+	problems := []string{}
+
+	for _, endpoint := range serviceEndpoints(s) {
+		conn, err := net.DialTimeout("tcp", endpoint, 5*time.Second)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s unreachable", endpoint))
+			continue
+		}
+
+		conn.Close()
+	}
+
+	return problems
+}
+
+// serviceEndpoints returns the host:port endpoints in the outputs of a service stack
+func serviceEndpoints(s *structs.Service) []string {
+	endpoints := []string{}
+
+	for key, host := range s.Outputs {
+		m := serviceEndpointOutput.FindStringSubmatch(key)
+		if m == nil || host == "" {
+			continue
+		}
+
+		port := s.Outputs[fmt.Sprintf("Port%sTcpPort", m[1])]
+		if port == "" {
+			port = m[1]
+		}
+
+		endpoints = append(endpoints, net.JoinHostPort(host, port))
+	}
+
+	sort.Strings(endpoints)
+
+	return endpoints
+}
+
+func serviceHealthKey(name string) string {
+	return fmt.Sprintf("services/%s/health", name)
+}
@@ -0,0 +1,24 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/convox/rack/api/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceEndpoints(t *testing.T) {
+	s := &structs.Service{
+		Outputs: map[string]string{
+			"Port6379TcpAddr": "cache.example.org",
+			"Port5432TcpAddr": "db.example.org",
+			"Port5432TcpPort": "15432",
+			"Port9200TcpAddr": "",
+			"Port80HttpAddr":  "web.example.org",
+			"Name":            "queue",
+		},
+	}
+
+	assert.Equal(t, []string{"cache.example.org:6379", "db.example.org:15432"}, serviceEndpoints(s))
+	assert.Equal(t, []string{}, serviceEndpoints(&structs.Service{}))
+}
@@ -7,6 +7,7 @@ import (
 	"html/template"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"regexp"
 	"strings"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/convox/rack/api/crypt"
+	"github.com/convox/rack/api/structs"
 )
 
 type Template struct {
@@ -218,6 +221,28 @@ func (p *AWSProvider) s3Delete(bucket, key string) error {
 	return err
 }
 
+// s3DeleteObjects deletes objects in batches and returns the number deleted
+func (p *AWSProvider) s3DeleteObjects(bucket string, objects []*s3.ObjectIdentifier) (int, error) {
+	// DeleteObjects takes at most 1000 keys per request
+	for i := 0; i < len(objects); i += 1000 {
+		j := i + 1000
+
+		if j > len(objects) {
+			j = len(objects)
+		}
+
+		_, err := p.s3().DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{Objects: objects[i:j], Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return len(objects), nil
+}
+
 func (p *AWSProvider) s3Put(bucket, key string, data []byte, public bool) error {
 	req := &s3.PutObjectInput{
 		Body:          bytes.NewReader(data),
@@ -235,6 +260,54 @@ func (p *AWSProvider) s3Put(bucket, key string, data []byte, public bool) error
 	return err
 }
 
+// settingsGet reads and decrypts an object from the app settings bucket.
+// A missing object returns no data.
+func (p *AWSProvider) settingsGet(a *structs.App, key string) ([]byte, error) {
+	data, err := p.s3Get(a.Outputs["Settings"], key)
+	if err != nil {
+		if aerr, ok := err.(awserr.RequestFailure); ok && aerr.StatusCode() == 404 {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if k := a.Parameters["Key"]; k != "" {
+		cr := crypt.New(os.Getenv("AWS_REGION"), os.Getenv("AWS_ACCESS"), os.Getenv("AWS_SECRET"))
+
+		data, err = cr.Decrypt(k, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// settingsPut encrypts and writes an object to the app settings bucket
+func (p *AWSProvider) settingsPut(a *structs.App, key string, data []byte) error {
+	switch a.Status {
+	case "creating":
+		return fmt.Errorf("app is still creating: %s", a.Name)
+	case "running", "updating":
+	default:
+		return fmt.Errorf("unable to update settings on app: %s", a.Name)
+	}
+
+	if k := a.Parameters["Key"]; k != "" {
+		cr := crypt.New(os.Getenv("AWS_REGION"), os.Getenv("AWS_ACCESS"), os.Getenv("AWS_SECRET"))
+
+		var err error
+
+		data, err = cr.Encrypt(k, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.s3Put(a.Outputs["Settings"], key, data, false)
+}
+
 func (p *AWSProvider) stackUpdate(name string, templateUrl string, changes map[string]string) error {
 	app, err := p.AppGet(name)
 
@@ -1,18 +1,31 @@
 package aws
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
-	"github.com/convox/rack/api/cache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/convox/rack/api/structs"
 )
 
 var (
 	IndexOperationConcurrency = 128
+
+	// IndexGCGracePeriod protects blobs that a build has not referenced yet from garbage
+	// collection: blobs uploaded within it and blobs a diff found within it. It must be
+	// longer than the longest build.
+	IndexGCGracePeriod = 24 * time.Hour
 )
 
 func (p *AWSProvider) IndexDiff(index *structs.Index) ([]string, error) {
@@ -47,6 +60,10 @@ func (p *AWSProvider) IndexDiff(index *structs.Index) ([]string, error) {
 
 	close(inch)
 
+	if err := p.indexPend(bucket, *index, missing); err != nil {
+		return nil, err
+	}
+
 	return missing, nil
 }
 
@@ -75,8 +92,319 @@ func (p *AWSProvider) IndexDownload(index *structs.Index, dir string) error {
 	return nil
 }
 
+// IndexUpload stores a gzipped blob. Blobs are marked with compression metadata
+// so blobs uploaded before compression was introduced can still be read.
 func (p *AWSProvider) IndexUpload(hash string, data []byte) error {
-	return p.s3Put(os.Getenv("SETTINGS_BUCKET"), fmt.Sprintf("index/%s", hash), data, false)
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+
+	if _, err := gz.Write(data); err != nil {
+		return err
+	}
+
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	_, err := p.s3().PutObject(&s3.PutObjectInput{
+		Body:          bytes.NewReader(buf.Bytes()),
+		Bucket:        aws.String(os.Getenv("SETTINGS_BUCKET")),
+		ContentLength: aws.Int64(int64(buf.Len())),
+		Key:           aws.String(fmt.Sprintf("index/%s", hash)),
+		Metadata: map[string]*string{
+			"Compression": aws.String("gzip"),
+		},
+	})
+
+	return err
+}
+
+// IndexGC deletes index blobs that are not referenced by any remaining build
+// and returns the number of blobs deleted
+func (p *AWSProvider) IndexGC() (int, error) {
+	bucket := os.Getenv("SETTINGS_BUCKET")
+
+	refs, _, err := p.indexReferences(bucket)
+	if err != nil {
+		return 0, err
+	}
+
+	blobs, err := p.indexBlobs(bucket)
+	if err != nil {
+		return 0, err
+	}
+
+	cutoff := time.Now().Add(-1 * IndexGCGracePeriod)
+
+	pending, expired, err := p.indexPending(bucket, cutoff)
+	if err != nil {
+		return 0, err
+	}
+
+	unused := []*s3.ObjectIdentifier{}
+
+	for _, blob := range blobs {
+		hash := strings.TrimPrefix(*blob.Key, "index/")
+
+		if _, ok := refs[hash]; ok {
+			continue
+		}
+
+		if pending[hash] {
+			continue
+		}
+
+		if blob.LastModified != nil && blob.LastModified.After(cutoff) {
+			continue
+		}
+
+		unused = append(unused, &s3.ObjectIdentifier{Key: blob.Key})
+	}
+
+	deleted, err := p.s3DeleteObjects(bucket, unused)
+	if err != nil {
+		return deleted, err
+	}
+
+	if _, err := p.s3DeleteObjects(bucket, expired); err != nil {
+		return deleted, err
+	}
+
+	return deleted, nil
+}
+
+// IndexForget removes the records of the blobs used by the builds of an app, so
+// garbage collection can delete blobs only the app referenced
+func (p *AWSProvider) IndexForget(app string) error {
+	bucket := os.Getenv("SETTINGS_BUCKET")
+
+	refs := []*s3.ObjectIdentifier{}
+
+	err := p.s3().ListObjectsPages(&s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(fmt.Sprintf("index-refs/%s/", app)),
+	}, func(res *s3.ListObjectsOutput, last bool) bool {
+		for _, o := range res.Contents {
+			refs = append(refs, &s3.ObjectIdentifier{Key: o.Key})
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = p.s3DeleteObjects(bucket, refs)
+
+	return err
+}
+
+// IndexStats reports storage used by index blobs and how well builds share them
+func (p *AWSProvider) IndexStats() (*structs.IndexStats, error) {
+	bucket := os.Getenv("SETTINGS_BUCKET")
+
+	refs, builds, err := p.indexReferences(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, err := p.indexBlobs(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &structs.IndexStats{
+		Blobs:  len(blobs),
+		Builds: builds,
+	}
+
+	for _, blob := range blobs {
+		stats.StoredBytes += *blob.Size
+
+		if _, ok := refs[strings.TrimPrefix(*blob.Key, "index/")]; ok {
+			stats.ReferencedBlobs++
+		} else {
+			stats.UnreferencedBlobs++
+		}
+	}
+
+	for _, ref := range refs {
+		stats.LogicalBytes += ref.Size * int64(ref.Count)
+		stats.UniqueBytes += ref.Size
+	}
+
+	if stats.UniqueBytes > 0 {
+		stats.DedupeRatio = float64(stats.LogicalBytes) / float64(stats.UniqueBytes)
+	}
+
+	return stats, nil
+}
+
+// indexRef tracks the size of a blob and how many builds reference it
+type indexRef struct {
+	Size  int64
+	Count int
+}
+
+// indexRecord saves the blobs a build used along with their sizes so
+// garbage collection knows which blobs are still referenced
+func (p *AWSProvider) indexRecord(app, build string, index structs.Index, dir string) error {
+	sizes := map[string]int64{}
+
+	for hash, item := range index {
+		if fi, err := os.Stat(filepath.Join(dir, item.Name)); err == nil {
+			sizes[hash] = fi.Size()
+		} else {
+			sizes[hash] = 0
+		}
+	}
+
+	data, err := json.Marshal(sizes)
+	if err != nil {
+		return err
+	}
+
+	return p.s3Put(os.Getenv("SETTINGS_BUCKET"), indexRefsKey(app, build), data, false)
+}
+
+// indexPend records the blobs of an index that a diff found already stored. An upload
+// skips these blobs, so until the build records its references this keeps garbage
+// collection from deleting them.
+func (p *AWSProvider) indexPend(bucket string, index structs.Index, missing []string) error {
+	skip := map[string]bool{}
+
+	for _, hash := range missing {
+		skip[hash] = true
+	}
+
+	hashes := []string{}
+
+	for hash := range index {
+		if !skip[hash] {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	sort.Strings(hashes)
+
+	data, err := json.Marshal(hashes)
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return p.s3Put(bucket, fmt.Sprintf("index-pending/%s.json", hex.EncodeToString(sum[:])), data, false)
+}
+
+// indexPending reads the blobs recorded by diffs since cutoff and returns the records
+// made before it, which no build can still be waiting on
+func (p *AWSProvider) indexPending(bucket string, cutoff time.Time) (map[string]bool, []*s3.ObjectIdentifier, error) {
+	keys := []string{}
+	expired := []*s3.ObjectIdentifier{}
+
+	err := p.s3().ListObjectsPages(&s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String("index-pending/"),
+	}, func(res *s3.ListObjectsOutput, last bool) bool {
+		for _, o := range res.Contents {
+			if o.LastModified != nil && o.LastModified.Before(cutoff) {
+				expired = append(expired, &s3.ObjectIdentifier{Key: o.Key})
+			} else {
+				keys = append(keys, *o.Key)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending := map[string]bool{}
+
+	for _, key := range keys {
+		data, err := p.s3Get(bucket, key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var hashes []string
+
+		if err := json.Unmarshal(data, &hashes); err != nil {
+			return nil, nil, err
+		}
+
+		for _, hash := range hashes {
+			pending[hash] = true
+		}
+	}
+
+	return pending, expired, nil
+}
+
+// indexReferences reads the recorded references of all builds
+func (p *AWSProvider) indexReferences(bucket string) (map[string]indexRef, int, error) {
+	keys := []string{}
+
+	err := p.s3().ListObjectsPages(&s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String("index-refs/"),
+	}, func(res *s3.ListObjectsOutput, last bool) bool {
+		for _, o := range res.Contents {
+			keys = append(keys, *o.Key)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	refs := map[string]indexRef{}
+
+	for _, key := range keys {
+		data, err := p.s3Get(bucket, key)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var sizes map[string]int64
+
+		if err := json.Unmarshal(data, &sizes); err != nil {
+			return nil, 0, err
+		}
+
+		for hash, size := range sizes {
+			ref := refs[hash]
+			ref.Size = size
+			ref.Count++
+			refs[hash] = ref
+		}
+	}
+
+	return refs, len(keys), nil
+}
+
+// indexBlobs lists the stored index blobs
+func (p *AWSProvider) indexBlobs(bucket string) ([]*s3.Object, error) {
+	blobs := []*s3.Object{}
+
+	err := p.s3().ListObjectsPages(&s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String("index/"),
+	}, func(res *s3.ListObjectsOutput, last bool) bool {
+		blobs = append(blobs, res.Contents...)
+		return true
+	})
+
+	return blobs, err
+}
+
+func indexRefsKey(app, build string) string {
+	return fmt.Sprintf("index-refs/%s/%s.json", app, build)
 }
 
 func (p *AWSProvider) downloadItems(bucket string, index structs.Index, dir string, inch chan string, errch chan error) {
@@ -86,12 +414,33 @@ func (p *AWSProvider) downloadItems(bucket string, index structs.Index, dir stri
 }
 
 func (p *AWSProvider) downloadItem(bucket, hash string, item structs.IndexItem, dir string) error {
-	data, err := p.s3Get(bucket, fmt.Sprintf("index/%s", hash))
+	res, err := p.s3().GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(fmt.Sprintf("index/%s", hash)),
+	})
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
 
+	data, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return err
 	}
 
+	if aws.StringValue(res.Metadata["Compression"]) == "gzip" {
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+
+		data, err = ioutil.ReadAll(gz)
+		if err != nil {
+			return err
+		}
+	}
+
 	file := filepath.Join(dir, item.Name)
 
 	err = os.MkdirAll(filepath.Dir(file), 0755)
@@ -129,20 +478,8 @@ func (p *AWSProvider) missingHashes(bucket string, inch, outch chan string, errc
 	}
 }
 
+// hashExists checks for a blob on every call, any api process can garbage collect
+// blobs so a cached answer could skip uploading a deleted blob
 func (p *AWSProvider) hashExists(bucket, hash string) (bool, error) {
-	if exists, ok := cache.Get("index.missingHash", hash).(bool); ok && exists {
-		return true, nil
-	}
-
-	exists, err := p.s3Exists(bucket, fmt.Sprintf("index/%s", hash))
-
-	if err != nil {
-		return false, err
-	}
-
-	if exists {
-		cache.Set("index.missingHash", hash, true, 30*24*time.Hour)
-	}
-
-	return exists, nil
+	return p.s3Exists(bucket, fmt.Sprintf("index/%s", hash))
 }
@@ -0,0 +1,363 @@
+package aws_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/convox/rack/api/awsutil"
+	"github.com/convox/rack/api/provider"
+	"github.com/convox/rack/api/provider/aws"
+	"github.com/convox/rack/api/structs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexStats(t *testing.T) {
+	aws := StubAwsProvider(
+		indexRefsListCycle,
+		indexRefsGetCycle1,
+		indexRefsGetCycle2,
+		indexBlobsListCycle,
+	)
+	defer aws.Close()
+
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	stats, err := provider.IndexStats()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, &structs.IndexStats{
+		Builds:            2,
+		Blobs:             3,
+		ReferencedBlobs:   2,
+		UnreferencedBlobs: 1,
+		StoredBytes:       600,
+		LogicalBytes:      1400,
+		UniqueBytes:       1100,
+		DedupeRatio:       float64(1400) / float64(1100),
+	}, stats)
+}
+
+func TestIndexGC(t *testing.T) {
+	aws := StubAwsProvider(
+		indexRefsListCycle,
+		indexRefsGetCycle1,
+		indexRefsGetCycle2,
+		indexBlobsListGCCycle,
+		indexPendingListCycle,
+		indexPendingGetCycle,
+		indexDeleteUnreferencedCycle,
+		indexDeletePendingCycle,
+	)
+	defer aws.Close()
+
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	// aaa and bbb are referenced, ccc is unreferenced, ddd is within the grace period and
+	// eee was found by a diff within the grace period
+	deleted, err := provider.IndexGC()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted)
+}
+
+func TestIndexForget(t *testing.T) {
+	aws := StubAwsProvider(
+		indexRefsAppListCycle,
+		indexRefsAppDeleteCycle,
+	)
+	defer aws.Close()
+
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	assert.Nil(t, provider.IndexForget("httpd"))
+}
+
+func TestIndexUploadDownload(t *testing.T) {
+	s3 := newIndexS3()
+	defer s3.Close()
+
+	defer func() {
+		provider.CurrentProvider = new(provider.TestProviderRunner)
+	}()
+
+	defer os.Setenv("AWS_ENDPOINT", os.Getenv("AWS_ENDPOINT"))
+
+	os.Setenv("AWS_ENDPOINT", s3.URL)
+
+	p, err := aws.NewProvider("test", "test", "test", s3.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider.CurrentProvider = p
+
+	dir, err := ioutil.TempDir("", "index")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	data := []byte("hello hello hello hello hello")
+
+	if err := provider.IndexUpload("aaa", data); err != nil {
+		t.Fatal(err)
+	}
+
+	stored := s3.objects["/convox-settings/index/aaa"]
+
+	assert.Equal(t, "gzip", stored.compression)
+	assert.NotEqual(t, data, stored.body)
+
+	// blobs uploaded before compression have no metadata
+	s3.objects["/convox-settings/index/bbb"] = indexObject{body: []byte("legacy")}
+
+	modified := time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	index := structs.Index{
+		"aaa": structs.IndexItem{Name: "app/hello.txt", Mode: 0644, ModTime: modified},
+		"bbb": structs.IndexItem{Name: "app/legacy.txt", Mode: 0644, ModTime: modified},
+	}
+
+	if err := provider.IndexDownload(&index, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	hello, err := ioutil.ReadFile(filepath.Join(dir, "app/hello.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, data, hello)
+
+	legacy, err := ioutil.ReadFile(filepath.Join(dir, "app/legacy.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "legacy", string(legacy))
+}
+
+// indexS3 stores objects with their compression metadata, which replayed cycles can
+// not return
+type indexS3 struct {
+	*httptest.Server
+
+	lock    sync.Mutex
+	objects map[string]indexObject
+}
+
+type indexObject struct {
+	body        []byte
+	compression string
+}
+
+func newIndexS3() *indexS3 {
+	s := &indexS3{objects: map[string]indexObject{}}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+func (s *indexS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch r.Method {
+	case "PUT":
+		body, _ := ioutil.ReadAll(r.Body)
+		s.objects[r.URL.Path] = indexObject{body: body, compression: r.Header.Get("X-Amz-Meta-Compression")}
+	case "GET":
+		o, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(404)
+			return
+		}
+
+		if o.compression != "" {
+			w.Header().Set("X-Amz-Meta-Compression", o.compression)
+		}
+
+		w.Write(o.body)
+	}
+}
+
+var indexRefsListCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings?prefix=index-refs%2F",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body: `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>convox-settings</Name>
+  <Prefix>index-refs/</Prefix>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>index-refs/httpd/B1.json</Key><Size>40</Size></Contents>
+  <Contents><Key>index-refs/httpd/B2.json</Key><Size>40</Size></Contents>
+</ListBucketResult>`,
+	},
+}
+
+var indexRefsGetCycle1 = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings/index-refs/httpd/B1.json",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body:       `{"aaa":300,"bbb":800}`,
+	},
+}
+
+var indexRefsGetCycle2 = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings/index-refs/httpd/B2.json",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body:       `{"aaa":300}`,
+	},
+}
+
+var indexBlobsListCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings?prefix=index%2F",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body: `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>convox-settings</Name>
+  <Prefix>index/</Prefix>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>index/aaa</Key><Size>100</Size><LastModified>2016-10-01T00:00:00.000Z</LastModified></Contents>
+  <Contents><Key>index/bbb</Key><Size>300</Size><LastModified>2016-10-01T00:00:00.000Z</LastModified></Contents>
+  <Contents><Key>index/ccc</Key><Size>200</Size><LastModified>2016-10-01T00:00:00.000Z</LastModified></Contents>
+</ListBucketResult>`,
+	},
+}
+
+var indexBlobsListGCCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings?prefix=index%2F",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body: `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>convox-settings</Name>
+  <Prefix>index/</Prefix>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>index/aaa</Key><Size>100</Size><LastModified>2016-10-01T00:00:00.000Z</LastModified></Contents>
+  <Contents><Key>index/bbb</Key><Size>300</Size><LastModified>2016-10-01T00:00:00.000Z</LastModified></Contents>
+  <Contents><Key>index/ccc</Key><Size>200</Size><LastModified>2016-10-01T00:00:00.000Z</LastModified></Contents>
+  <Contents><Key>index/ddd</Key><Size>200</Size><LastModified>2100-01-01T00:00:00.000Z</LastModified></Contents>
+  <Contents><Key>index/eee</Key><Size>200</Size><LastModified>2016-10-01T00:00:00.000Z</LastModified></Contents>
+</ListBucketResult>`,
+	},
+}
+
+var indexPendingListCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings?prefix=index-pending%2F",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body: `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>convox-settings</Name>
+  <Prefix>index-pending/</Prefix>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>index-pending/P1.json</Key><Size>10</Size><LastModified>2100-01-01T00:00:00.000Z</LastModified></Contents>
+  <Contents><Key>index-pending/P2.json</Key><Size>10</Size><LastModified>2016-10-01T00:00:00.000Z</LastModified></Contents>
+</ListBucketResult>`,
+	},
+}
+
+var indexPendingGetCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings/index-pending/P1.json",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body:       `["eee"]`,
+	},
+}
+
+var indexDeletePendingCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings?delete=",
+		Operation:  "",
+		Body:       `/^<Delete>(<Quiet>true</Quiet>)?<Object><Key>index-pending/P2.json</Key></Object>(<Quiet>true</Quiet>)?</Delete>$/`,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body: `<?xml version="1.0" encoding="UTF-8"?>
+<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></DeleteResult>`,
+	},
+}
+
+var indexDeleteUnreferencedCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings?delete=",
+		Operation:  "",
+		Body:       `/^<Delete>(<Quiet>true</Quiet>)?<Object><Key>index/ccc</Key></Object>(<Quiet>true</Quiet>)?</Delete>$/`,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body: `<?xml version="1.0" encoding="UTF-8"?>
+<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></DeleteResult>`,
+	},
+}
+
+var indexRefsAppListCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings?prefix=index-refs%2Fhttpd%2F",
+		Operation:  "",
+		Body:       ``,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body: `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>convox-settings</Name>
+  <Prefix>index-refs/httpd/</Prefix>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>index-refs/httpd/B1.json</Key><Size>40</Size></Contents>
+  <Contents><Key>index-refs/httpd/B2.json</Key><Size>40</Size></Contents>
+</ListBucketResult>`,
+	},
+}
+
+var indexRefsAppDeleteCycle = awsutil.Cycle{
+	Request: awsutil.Request{
+		RequestURI: "/convox-settings?delete=",
+		Operation:  "",
+		Body:       `/^<Delete>(<Quiet>true</Quiet>)?<Object><Key>index-refs/httpd/B1.json</Key></Object><Object><Key>index-refs/httpd/B2.json</Key></Object>(<Quiet>true</Quiet>)?</Delete>$/`,
+	},
+	Response: awsutil.Response{
+		StatusCode: 200,
+		Body: `<?xml version="1.0" encoding="UTF-8"?>
+<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></DeleteResult>`,
+	},
+}
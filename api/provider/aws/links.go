@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/convox/rack/api/structs"
+)
+
+// LinkList returns the services linked to an app through its environment
+func (p *AWSProvider) LinkList(app string) (structs.Links, error) {
+	a, err := p.AppGet(app)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.appLinks(a)
+}
+
+func (p *AWSProvider) appLinks(a *structs.App) (structs.Links, error) {
+	links := structs.Links{}
+
+	data, err := p.settingsGet(a, "links")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return links, nil
+	}
+
+	if err := json.Unmarshal(data, &links); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (p *AWSProvider) appLinksSave(a *structs.App, links structs.Links) error {
+	data, err := json.Marshal(links)
+	if err != nil {
+		return err
+	}
+
+	return p.settingsPut(a, "links", data)
+}
+
+// linkRelease creates a release of an app with changes to its environment. Releases
+// also pick up the links scoped to processes, so they are created for those too.
+func (p *AWSProvider) linkRelease(a *structs.App, change func(env structs.Environment)) (*structs.Release, error) {
+	data, err := p.settingsGet(a, "env")
+	if err != nil {
+		return nil, err
+	}
+
+	env := structs.LoadEnvironment(data)
+
+	change(env)
+
+	if err := p.settingsPut(a, "env", []byte(env.Raw())); err != nil {
+		return nil, err
+	}
+
+	releases, err := p.ReleaseList(a.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := structs.NewRelease(a.Name)
+	id := r.Id
+
+	if len(releases) > 0 {
+		r = &releases[0]
+	}
+
+	r.Id = id
+	r.Created = time.Time{}
+	r.Env = env.Raw()
+
+	if err := p.ReleaseSave(r, a.Outputs["Settings"], a.Parameters["Key"]); err != nil {
+		return nil, err
+	}
+
+	p.EventSend(&structs.Event{
+		Action: "release:create",
+		Data: map[string]string{
+			"app": r.App,
+			"id":  r.Id,
+		},
+	}, nil)
+
+	return r, nil
+}
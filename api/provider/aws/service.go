@@ -26,6 +26,8 @@ func (p *AWSProvider) ServiceCreate(name, kind string, params map[string]string)
 	var req *cloudformation.CreateStackInput
 
 	switch s.Type {
+	case "external":
+		req, err = p.createExternal(s)
 	case "papertrail":
 		err = fmt.Errorf("papertrail is no longer supported. Create a `syslog` service instead")
 	case "syslog":
@@ -60,6 +62,10 @@ func (p *AWSProvider) ServiceCreate(name, kind string, params map[string]string)
 
 	_, err = p.cloudformation().CreateStack(req)
 
+	if err != nil && s.Type == "external" {
+		p.s3Delete(os.Getenv("SETTINGS_BUCKET"), externalCredentialsKey(s.Name))
+	}
+
 	p.EventSend(&structs.Event{
 		Action: "service:create",
 		Data: map[string]string{
@@ -81,6 +87,10 @@ func (p *AWSProvider) ServiceDelete(name string) (*structs.Service, error) {
 		StackName: aws.String(serviceStackName(s)),
 	})
 
+	if err == nil && s.Type == "external" {
+		err = p.s3Delete(os.Getenv("SETTINGS_BUCKET"), externalCredentialsKey(s.Name))
+	}
+
 	p.EventSend(&structs.Event{
 		Action: "service:delete",
 		Data: map[string]string{
@@ -118,6 +128,16 @@ func (p *AWSProvider) ServiceGet(name string) (*structs.Service, error) {
 		return nil, fmt.Errorf("no such stack on this rack: %s", name)
 	}
 
+	// external services add their credentials to the url
+	if s.Type == "external" && s.Status == "running" {
+		u, err := p.externalURL(&s)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Exports["URL"] = u
+	}
+
 	if s.Status == "failed" {
 		eres, err := p.describeStackEvents(&cloudformation.DescribeStackEventsInput{
 			StackName: aws.String(*res.Stacks[0].StackName),
@@ -152,7 +172,7 @@ func (p *AWSProvider) ServiceGet(name string) (*structs.Service, error) {
 	return &s, nil
 }
 
-func (p *AWSProvider) ServiceLink(name, app, process string) (*structs.Service, error) {
+func (p *AWSProvider) ServiceLink(name, app, process, prefix string) (*structs.Service, error) {
 	a, err := p.AppGet(app)
 	if err != nil {
 		return nil, err
@@ -176,23 +196,25 @@ func (p *AWSProvider) ServiceLink(name, app, process string) (*structs.Service,
 		return nil, fmt.Errorf("Papertrail linking is no longer supported. Delete the papertrail service and create a new `syslog` service instead")
 	}
 
-	// can't link to process or process does not exist
-	if process != "" {
-		switch s.Type {
-		default:
-			return nil, fmt.Errorf("Service type %s can not replace a process", s.Type)
+	// only links through the environment can be scoped to a process or prefixed
+	if serviceLink(s.Type) == "subscribe" {
+		if process != "" {
+			return nil, fmt.Errorf("Service type %s can not be linked to a process", s.Type)
+		}
+
+		if prefix != "" {
+			return nil, fmt.Errorf("Service type %s does not set environment variables", s.Type)
 		}
-		// TODO: Port Resource and Resources and validate that UpperName(process)+"ECSTaskDefinition" exists
 	}
 
 	// Update Service and/or App stacks
-	switch s.Type {
-	case "syslog":
+	switch serviceLink(s.Type) {
+	case "subscribe":
 		err = p.ServiceLinkSubscribe(a, s) // Update service to know about App
-	case "s3", "sns", "sqs":
-		err = p.ServiceLinkSet(a, s) // Updates app with S3_URL
-	case "postgres":
-		err = p.ServiceLinkReplace(a, s) // Updates app with POSTGRES_URL and PostgresCount=0
+	case "set":
+		err = p.ServiceLinkSet(a, s, process, prefix) // Updates app with S3_URL
+	case "replace":
+		err = p.ServiceLinkReplace(a, s, process, prefix) // Updates app with POSTGRES_URL
 	default:
 		err = fmt.Errorf("Service type %s does not have a link strategy", s.Type)
 	}
@@ -200,12 +222,54 @@ func (p *AWSProvider) ServiceLink(name, app, process string) (*structs.Service,
 	return s, err
 }
 
-func (p *AWSProvider) ServiceLinkReplace(a *structs.App, s *structs.Service) error {
-	return fmt.Errorf("Replacing a process with a service is not yet implemented")
+// ServiceLinkReplace links a service that replaces a process of the same name the app
+// runs locally, which `convox start` swaps for a stand-in, so it sets the environment
+// just like ServiceLinkSet
+func (p *AWSProvider) ServiceLinkReplace(a *structs.App, s *structs.Service, process, prefix string) error {
+	return p.ServiceLinkSet(a, s, process, prefix)
 }
 
-func (p *AWSProvider) ServiceLinkSet(a *structs.App, s *structs.Service) error {
-	return fmt.Errorf("Setting an environment variable for a service is not yet implemented")
+// ServiceLinkSet records the link and sets the URL of the service in the environment of
+// the app, or only of the process it is scoped to, with a new release
+func (p *AWSProvider) ServiceLinkSet(a *structs.App, s *structs.Service, process, prefix string) error {
+	url := s.Exports["URL"]
+	if url == "" {
+		return fmt.Errorf("Service %s does not have a URL to link", s.Name)
+	}
+
+	links, err := p.appLinks(a)
+	if err != nil {
+		return err
+	}
+
+	link := structs.Link{
+		App:      a.Name,
+		Service:  s.Name,
+		Process:  process,
+		Variable: structs.LinkVariable(s.Name, prefix),
+	}
+
+	for _, l := range links {
+		if l.Service == link.Service && l.Process == link.Process {
+			return fmt.Errorf("Service %s is already linked to app %s", s.Name, a.Name)
+		}
+
+		if l.Conflicts(link) {
+			return fmt.Errorf("%s is already set by the link to %s, choose another prefix with --as", link.Variable, l.Service)
+		}
+	}
+
+	if err := p.appLinksSave(a, append(links, link)); err != nil {
+		return err
+	}
+
+	_, err = p.linkRelease(a, func(env structs.Environment) {
+		if process == "" {
+			env[link.Variable] = url
+		}
+	})
+
+	return err
 }
 
 func (p *AWSProvider) ServiceLinkSubscribe(a *structs.App, s *structs.Service) error {
@@ -251,26 +315,26 @@ func (p *AWSProvider) ServiceUnlink(name, app, process string) (*structs.Service
 	}
 
 	// already linked
-	linked := false
-	for _, linkedApp := range s.Apps {
-		if a.Name == linkedApp.Name {
-			linked = true
-			break
+	if serviceLink(s.Type) == "subscribe" {
+		linked := false
+		for _, linkedApp := range s.Apps {
+			if a.Name == linkedApp.Name {
+				linked = true
+				break
+			}
 		}
-	}
 
-	if !linked {
-		return nil, fmt.Errorf("Service %s is not linked to app %s", s.Name, a.Name)
+		if !linked {
+			return nil, fmt.Errorf("Service %s is not linked to app %s", s.Name, a.Name)
+		}
 	}
 
 	// Update Service and/or App stacks
-	switch s.Type {
-	case "syslog":
+	switch serviceLink(s.Type) {
+	case "subscribe":
 		err = p.ServiceUnlinkSubscribe(a, s) // Update service to forget about App
-	case "s3", "sns", "sqs":
-		err = p.ServiceUnlinkSet(a, s) // Updates app without S3_URL
-	case "postgres":
-		err = p.ServiceUnlinkReplace(a, s) // Updates app without POSTGRES_URL and PostgresCount=1
+	case "set", "replace":
+		err = p.ServiceUnlinkSet(a, s, process) // Updates app without S3_URL
 	default:
 		err = fmt.Errorf("Service type %s does not have a unlink strategy", s.Type)
 	}
@@ -278,12 +342,45 @@ func (p *AWSProvider) ServiceUnlink(name, app, process string) (*structs.Service
 	return s, err
 }
 
-func (p *AWSProvider) ServiceUnlinkReplace(a *structs.App, s *structs.Service) error {
-	return fmt.Errorf("Un-replacing a process with a service is not yet implemented")
-}
+// ServiceUnlinkSet removes the link scoped to process and its variable from the
+// environment of the app with a new release
+func (p *AWSProvider) ServiceUnlinkSet(a *structs.App, s *structs.Service, process string) error {
+	links, err := p.appLinks(a)
+	if err != nil {
+		return err
+	}
+
+	remaining := structs.Links{}
+	var removed *structs.Link
+
+	for i, l := range links {
+		if l.Service == s.Name && l.Process == process {
+			removed = &links[i]
+			continue
+		}
+
+		remaining = append(remaining, l)
+	}
 
-func (p *AWSProvider) ServiceUnlinkSet(a *structs.App, s *structs.Service) error {
-	return fmt.Errorf("Un-setting an environment variable for a service is not yet implemented")
+	if removed == nil {
+		if process != "" {
+			return fmt.Errorf("Service %s is not linked to process %s of app %s", s.Name, process, a.Name)
+		}
+
+		return fmt.Errorf("Service %s is not linked to app %s", s.Name, a.Name)
+	}
+
+	if err := p.appLinksSave(a, remaining); err != nil {
+		return err
+	}
+
+	_, err = p.linkRelease(a, func(env structs.Environment) {
+		if removed.Process == "" {
+			delete(env, removed.Variable)
+		}
+	})
+
+	return err
 }
 
 func (p *AWSProvider) ServiceUnlinkSubscribe(a *structs.App, s *structs.Service) error {
@@ -367,9 +464,10 @@ func serviceFromStack(stack *cloudformation.Stack) structs.Service {
 	exports := make(map[string]string)
 
 	if humanStatus(*stack.StackStatus) == "running" {
-		switch tags["Service"] {
-		case "syslog":
-			exports["URL"] = params["Url"]
+		if t, err := structs.GetServiceType(tags["Service"]); err == nil && t.URL != nil {
+			if u := t.URL(stackOutputs(stack), params); u != "" {
+				exports["URL"] = u
+			}
 		}
 	}
 
@@ -384,6 +482,16 @@ func serviceFromStack(stack *cloudformation.Stack) structs.Service {
 	}
 }
 
+// serviceLink returns the link strategy of a service type
+func serviceLink(kind string) string {
+	t, err := structs.GetServiceType(kind)
+	if err != nil {
+		return ""
+	}
+
+	return t.Link
+}
+
 func serviceStackName(s *structs.Service) string {
 	// Tags are present but "Name" tag is not so we have an "unbound" service with no rack name prefix
 	if s.Tags != nil && s.Tags["Name"] == "" {
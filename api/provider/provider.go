@@ -15,14 +15,18 @@ type Provider interface {
 	AppGet(name string) (*structs.App, error)
 
 	BuildCopy(srcApp, id, destApp string) (*structs.Build, error)
+	BuildCredentialsGet(app string) (*structs.BuildCredentials, error)
+	BuildCredentialsSave(app string, creds *structs.BuildCredentials) error
 	BuildCreateIndex(app string, index structs.Index, manifest, description string, cache bool) (*structs.Build, error)
-	BuildCreateRepo(app, url, manifest, description string, cache bool) (*structs.Build, error)
+	BuildCreateRepo(app, url, ref, dir, manifest, description string, cache bool) (*structs.Build, error)
 	BuildCreateTar(app string, src io.Reader, manifest, description string, cache bool) (*structs.Build, error)
 	BuildDelete(app, id string) (*structs.Build, error)
 	BuildGet(app, id string) (*structs.Build, error)
 	BuildList(app string, limit int64) (structs.Builds, error)
 	BuildRelease(*structs.Build) (*structs.Release, error)
 	BuildSave(*structs.Build) error
+	BuildSecretsGet(app string) (structs.Environment, error)
+	BuildSecretsSave(app string, env structs.Environment) error
 
 	CapacityGet() (*structs.Capacity, error)
 
@@ -35,10 +39,15 @@ type Provider interface {
 
 	IndexDiff(*structs.Index) ([]string, error)
 	IndexDownload(*structs.Index, string) error
+	IndexForget(app string) error
+	IndexGC() (int, error)
+	IndexStats() (*structs.IndexStats, error)
 	IndexUpload(string, []byte) error
 
 	InstanceList() (structs.Instances, error)
 
+	LinkList(app string) (structs.Links, error)
+
 	LogStream(app string, w io.Writer, opts structs.LogStreamOptions) error
 
 	ReleaseDelete(app, id string) (*structs.Release, error)
@@ -50,7 +59,9 @@ type Provider interface {
 	ServiceCreate(name, kind string, params map[string]string) (*structs.Service, error)
 	ServiceDelete(name string) (*structs.Service, error)
 	ServiceGet(name string) (*structs.Service, error)
-	ServiceLink(name, app, process string) (*structs.Service, error)
+	ServiceHealthCheck(name string) (*structs.ServiceHealth, error)
+	ServiceHealthGet(name string) (*structs.ServiceHealth, error)
+	ServiceLink(name, app, process, prefix string) (*structs.Service, error)
 	ServiceUnlink(name, app, process string) (*structs.Service, error)
 
 	SystemGet() (*structs.System, error)
@@ -84,12 +95,20 @@ func BuildCopy(srcApp, id, destApp string) (*structs.Build, error) {
 	return CurrentProvider.BuildCopy(srcApp, id, destApp)
 }
 
+func BuildCredentialsGet(app string) (*structs.BuildCredentials, error) {
+	return CurrentProvider.BuildCredentialsGet(app)
+}
+
+func BuildCredentialsSave(app string, creds *structs.BuildCredentials) error {
+	return CurrentProvider.BuildCredentialsSave(app, creds)
+}
+
 func BuildCreateIndex(app string, index structs.Index, manifest, description string, cache bool) (*structs.Build, error) {
 	return CurrentProvider.BuildCreateIndex(app, index, manifest, description, cache)
 }
 
-func BuildCreateRepo(app, url, manifest, description string, cache bool) (*structs.Build, error) {
-	return CurrentProvider.BuildCreateRepo(app, url, manifest, description, cache)
+func BuildCreateRepo(app, url, ref, dir, manifest, description string, cache bool) (*structs.Build, error) {
+	return CurrentProvider.BuildCreateRepo(app, url, ref, dir, manifest, description, cache)
 }
 
 func BuildCreateTar(app string, src io.Reader, manifest, description string, cache bool) (*structs.Build, error) {
@@ -116,6 +135,14 @@ func BuildSave(b *structs.Build) error {
 	return CurrentProvider.BuildSave(b)
 }
 
+func BuildSecretsGet(app string) (structs.Environment, error) {
+	return CurrentProvider.BuildSecretsGet(app)
+}
+
+func BuildSecretsSave(app string, env structs.Environment) error {
+	return CurrentProvider.BuildSecretsSave(app, env)
+}
+
 func CapacityGet() (*structs.Capacity, error) {
 	return CurrentProvider.CapacityGet()
 }
@@ -148,6 +175,18 @@ func IndexDownload(i *structs.Index, dir string) error {
 	return CurrentProvider.IndexDownload(i, dir)
 }
 
+func IndexForget(app string) error {
+	return CurrentProvider.IndexForget(app)
+}
+
+func IndexGC() (int, error) {
+	return CurrentProvider.IndexGC()
+}
+
+func IndexStats() (*structs.IndexStats, error) {
+	return CurrentProvider.IndexStats()
+}
+
 func IndexUpload(hash string, data []byte) error {
 	return CurrentProvider.IndexUpload(hash, data)
 }
@@ -156,6 +195,10 @@ func InstanceList() (structs.Instances, error) {
 	return CurrentProvider.InstanceList()
 }
 
+func LinkList(app string) (structs.Links, error) {
+	return CurrentProvider.LinkList(app)
+}
+
 func LogStream(app string, w io.Writer, opts structs.LogStreamOptions) error {
 	return CurrentProvider.LogStream(app, w, opts)
 }
@@ -192,8 +235,16 @@ func ServiceGet(name string) (*structs.Service, error) {
 	return CurrentProvider.ServiceGet(name)
 }
 
-func ServiceLink(name, app, process string) (*structs.Service, error) {
-	return CurrentProvider.ServiceLink(name, app, process)
+func ServiceHealthCheck(name string) (*structs.ServiceHealth, error) {
+	return CurrentProvider.ServiceHealthCheck(name)
+}
+
+func ServiceHealthGet(name string) (*structs.ServiceHealth, error) {
+	return CurrentProvider.ServiceHealthGet(name)
+}
+
+func ServiceLink(name, app, process, prefix string) (*structs.Service, error) {
+	return CurrentProvider.ServiceLink(name, app, process, prefix)
 }
 
 func ServiceUnlink(name, app, process string) (*structs.Service, error) {
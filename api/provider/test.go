@@ -11,16 +11,20 @@ var TestProvider = &TestProviderRunner{}
 
 type TestProviderRunner struct {
 	mock.Mock
-	App          structs.App
-	Build        structs.Build
-	Builds       structs.Builds
-	Capacity     structs.Capacity
-	Certificate  structs.Certificate
-	Certificates structs.Certificates
-	Instances    structs.Instances
-	Release      structs.Release
-	Releases     structs.Releases
-	Service      structs.Service
+	App             structs.App
+	Build           structs.Build
+	Builds          structs.Builds
+	BuildCreds      structs.BuildCredentials
+	BuildSecrets    structs.Environment
+	Capacity        structs.Capacity
+	Certificate     structs.Certificate
+	Certificates    structs.Certificates
+	IndexStatistics structs.IndexStats
+	Instances       structs.Instances
+	Links           structs.Links
+	Release         structs.Release
+	Releases        structs.Releases
+	Service         structs.Service
 }
 
 func (p *TestProviderRunner) AppGet(name string) (*structs.App, error) {
@@ -33,13 +37,23 @@ func (p *TestProviderRunner) BuildCopy(srcApp, id, destApp string) (*structs.Bui
 	return &p.Build, nil
 }
 
+func (p *TestProviderRunner) BuildCredentialsGet(app string) (*structs.BuildCredentials, error) {
+	p.Called(app)
+	return &p.BuildCreds, nil
+}
+
+func (p *TestProviderRunner) BuildCredentialsSave(app string, creds *structs.BuildCredentials) error {
+	p.Called(app, creds)
+	return nil
+}
+
 func (p *TestProviderRunner) BuildCreateIndex(app string, index structs.Index, manifest, description string, cache bool) (*structs.Build, error) {
 	p.Called(app, index, manifest, description, cache)
 	return &p.Build, nil
 }
 
-func (p *TestProviderRunner) BuildCreateRepo(app, url, manifest, description string, cache bool) (*structs.Build, error) {
-	p.Called(app, url, manifest, description, cache)
+func (p *TestProviderRunner) BuildCreateRepo(app, url, ref, dir, manifest, description string, cache bool) (*structs.Build, error) {
+	p.Called(app, url, ref, dir, manifest, description, cache)
 	return &p.Build, nil
 }
 
@@ -74,6 +88,16 @@ func (p *TestProviderRunner) BuildSave(b *structs.Build) error {
 	return nil
 }
 
+func (p *TestProviderRunner) BuildSecretsGet(app string) (structs.Environment, error) {
+	p.Called(app)
+	return p.BuildSecrets, nil
+}
+
+func (p *TestProviderRunner) BuildSecretsSave(app string, env structs.Environment) error {
+	p.Called(app, env)
+	return nil
+}
+
 func (p *TestProviderRunner) CapacityGet() (*structs.Capacity, error) {
 	p.Called()
 	return &p.Capacity, nil
@@ -114,6 +138,21 @@ func (p *TestProviderRunner) IndexDownload(i *structs.Index, dir string) error {
 	return nil
 }
 
+func (p *TestProviderRunner) IndexForget(app string) error {
+	p.Called(app)
+	return nil
+}
+
+func (p *TestProviderRunner) IndexGC() (int, error) {
+	p.Called()
+	return 0, nil
+}
+
+func (p *TestProviderRunner) IndexStats() (*structs.IndexStats, error) {
+	p.Called()
+	return &p.IndexStatistics, nil
+}
+
 func (p *TestProviderRunner) IndexUpload(hash string, data []byte) error {
 	p.Called(hash, data)
 	return nil
@@ -124,6 +163,11 @@ func (p *TestProviderRunner) InstanceList() (structs.Instances, error) {
 	return p.Instances, nil
 }
 
+func (p *TestProviderRunner) LinkList(app string) (structs.Links, error) {
+	p.Called(app)
+	return p.Links, nil
+}
+
 func (p *TestProviderRunner) LogStream(app string, w io.Writer, opts structs.LogStreamOptions) error {
 	p.Called(app, w, opts)
 	return nil
@@ -169,8 +213,18 @@ func (p *TestProviderRunner) ServiceGet(name string) (*structs.Service, error) {
 	return &p.Service, nil
 }
 
-func (p *TestProviderRunner) ServiceLink(name, app, process string) (*structs.Service, error) {
-	p.Called(name, app, process)
+func (p *TestProviderRunner) ServiceHealthCheck(name string) (*structs.ServiceHealth, error) {
+	p.Called(name)
+	return p.Service.Health, nil
+}
+
+func (p *TestProviderRunner) ServiceHealthGet(name string) (*structs.ServiceHealth, error) {
+	p.Called(name)
+	return p.Service.Health, nil
+}
+
+func (p *TestProviderRunner) ServiceLink(name, app, process, prefix string) (*structs.Service, error) {
+	p.Called(name, app, process, prefix)
 	return &p.Service, nil
 }
 
@@ -12,6 +12,13 @@ type Build struct {
 	Manifest string `json:"manifest"`
 	Release  string `json:"release"`
 
+	Author string `json:"author"`
+	Branch string `json:"branch"`
+	Commit string `json:"commit"`
+
+	BuilderVersion string                `json:"builder-version"`
+	Images         map[string]BuildImage `json:"images"`
+
 	Status string `json:"status"`
 	Reason string `json:"reason"`
 
@@ -23,6 +30,18 @@ type Build struct {
 
 type Builds []Build
 
+// BuildImage describes the image pushed for a service of a build
+type BuildImage struct {
+	Digest string `json:"digest"`
+	Size   int64  `json:"size"`
+}
+
+// BuildCredentials authenticate builds that clone an app's private git repositories
+type BuildCredentials struct {
+	SSHKey string `json:"ssh-key"`
+	Token  string `json:"token"`
+}
+
 func NewBuild(app string) *Build {
 	return &Build{
 		App:    app,
@@ -0,0 +1,52 @@
+package structs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Environment map[string]string
+
+// LoadEnvironment parses KEY=VALUE lines into an Environment
+func LoadEnvironment(data []byte) Environment {
+	env := Environment{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), "=", 2)
+
+		if len(parts) == 2 {
+			if key := strings.TrimSpace(parts[0]); key != "" {
+				env[key] = parts[1]
+			}
+		}
+	}
+
+	return env
+}
+
+func (e Environment) SortedNames() []string {
+	names := []string{}
+
+	for key := range e {
+		names = append(names, key)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (e Environment) Raw() string {
+	lines := make([]string, len(e))
+
+	for i, name := range e.SortedNames() {
+		lines[i] = fmt.Sprintf("%s=%s", name, e[name])
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -12,3 +12,17 @@ type IndexItem struct {
 	Mode    os.FileMode `json:"mode"`
 	ModTime time.Time   `json:"mtime"`
 }
+
+// IndexStats summarizes the storage used by incremental build indexes
+type IndexStats struct {
+	Builds int `json:"builds"`
+
+	Blobs             int   `json:"blobs"`
+	ReferencedBlobs   int   `json:"referenced-blobs"`
+	UnreferencedBlobs int   `json:"unreferenced-blobs"`
+	StoredBytes       int64 `json:"stored-bytes"`
+
+	LogicalBytes int64   `json:"logical-bytes"`
+	UniqueBytes  int64   `json:"unique-bytes"`
+	DedupeRatio  float64 `json:"dedupe-ratio"`
+}
@@ -0,0 +1,39 @@
+package structs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Link is a service linked to an app, which sets Variable to the URL of the service in
+// the environment of every process of the app or only of Process
+type Link struct {
+	App      string `json:"app"`
+	Service  string `json:"service"`
+	Process  string `json:"process,omitempty"`
+	Variable string `json:"variable"`
+}
+
+type Links []Link
+
+// LinkVariable returns the environment variable a link sets, named after the service
+// unless given a prefix
+func LinkVariable(service, prefix string) string {
+	if prefix == "" {
+		prefix = service
+	}
+
+	prefix = strings.ToUpper(strings.Replace(prefix, "-", "_", -1))
+	prefix = strings.TrimSuffix(prefix, "_URL")
+
+	return fmt.Sprintf("%s_URL", prefix)
+}
+
+// Conflicts returns true when both links would set the same variable for a process
+func (l Link) Conflicts(other Link) bool {
+	if l.Variable != other.Variable {
+		return false
+	}
+
+	return l.Process == "" || other.Process == "" || l.Process == other.Process
+}
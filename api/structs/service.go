@@ -8,6 +8,7 @@ type Service struct {
 
 	Apps    Apps              `json:"apps"`
 	Exports map[string]string `json:"exports"`
+	Health  *ServiceHealth    `json:"health,omitempty"`
 
 	Outputs    map[string]string `json:"-"`
 	Parameters map[string]string `json:"-"`
@@ -0,0 +1,16 @@
+package structs
+
+import "time"
+
+// ServiceHealth is the result of the latest health check of a service
+type ServiceHealth struct {
+	Status  string             `json:"status"`
+	Reason  string             `json:"reason,omitempty"`
+	Checked time.Time          `json:"checked"`
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+}
+
+// Healthy returns true unless a check of the service failed
+func (h *ServiceHealth) Healthy() bool {
+	return h.Status == "healthy"
+}
@@ -0,0 +1,194 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ServiceType describes a kind of service a rack can create
+type ServiceType struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Parameters  []ServiceParameter `json:"parameters"`
+
+	// Link is how a service of this type is linked to an app: subscribe updates the
+	// service with the app, set and replace update the app with the service. Types
+	// without a link strategy can not be linked.
+	Link string `json:"link,omitempty"`
+
+	// Backups are supported by database types, which snapshot their data
+	Backups bool `json:"backups,omitempty"`
+
+	// Template is the name of the formation template for the service
+	Template string `json:"-"`
+
+	// Provider types are created and shown by the provider instead of the models
+	Provider bool `json:"-"`
+
+	// Unsupported is the error for types existing services may have but that can no
+	// longer be created
+	Unsupported string `json:"-"`
+
+	// URL returns the URL export of a running service from the outputs and parameters
+	// of its stack
+	URL func(outputs, parameters map[string]string) string `json:"-"`
+}
+
+type ServiceTypes []ServiceType
+
+// ServiceParameter is an option of a service type, named as on the command line
+type ServiceParameter struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Default     string   `json:"default,omitempty"`
+	Allowed     []string `json:"allowed,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Required    bool     `json:"required,omitempty"`
+	Secret      bool     `json:"secret,omitempty"`
+}
+
+var serviceTypes = map[string]ServiceType{}
+
+// RegisterServiceType adds a service type to the registry, replacing a type with the
+// same name
+func RegisterServiceType(t ServiceType) {
+	if t.Template == "" {
+		t.Template = fmt.Sprintf("service/%s", t.Name)
+	}
+
+	serviceTypes[t.Name] = t
+}
+
+// GetServiceType returns a registered service type
+func GetServiceType(name string) (*ServiceType, error) {
+	t, ok := serviceTypes[name]
+	if !ok {
+		names := []string{}
+
+		for _, t := range ListServiceTypes() {
+			names = append(names, t.Name)
+		}
+
+		return nil, fmt.Errorf("invalid service type: %s, must be one of: %s", name, strings.Join(names, ", "))
+	}
+
+	return &t, nil
+}
+
+// ListServiceTypes returns the service types that can be created, sorted by name
+func ListServiceTypes() ServiceTypes {
+	types := ServiceTypes{}
+
+	for _, t := range serviceTypes {
+		if t.Unsupported == "" {
+			types = append(types, t)
+		}
+	}
+
+	sort.Sort(types)
+
+	return types
+}
+
+// Parameter returns the parameter with a name
+func (t ServiceType) Parameter(name string) (ServiceParameter, bool) {
+	for _, p := range t.Parameters {
+		if p.Name == name {
+			return p, true
+		}
+	}
+
+	return ServiceParameter{}, false
+}
+
+// Validate checks options given on the command line against the parameters of the type
+func (t ServiceType) Validate(options map[string]string) error {
+	if err := t.ValidateChanges(options); err != nil {
+		return err
+	}
+
+	for _, p := range t.Parameters {
+		if _, ok := options[p.Name]; p.Required && !ok {
+			return fmt.Errorf("--%s is required for %s", p.Name, t.Name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateChanges checks options given to update a service, which need not include the
+// required ones
+func (t ServiceType) ValidateChanges(options map[string]string) error {
+	if t.Unsupported != "" {
+		return errors.New(t.Unsupported)
+	}
+
+	names := []string{}
+
+	for _, p := range t.Parameters {
+		names = append(names, "--"+p.Name)
+	}
+
+	keys := []string{}
+
+	for key := range options {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		p, ok := t.Parameter(key)
+		if !ok {
+			if len(names) == 0 {
+				return fmt.Errorf("%s services do not have options", t.Name)
+			}
+
+			return fmt.Errorf("invalid option for %s: --%s, must be one of: %s", t.Name, key, strings.Join(names, ", "))
+		}
+
+		if err := p.Validate(options[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks a value against the type and allowed values of the parameter
+func (p ServiceParameter) Validate(value string) error {
+	switch p.Type {
+	case "boolean":
+		if value != "true" && value != "false" {
+			return fmt.Errorf("--%s must be true or false", p.Name)
+		}
+	case "number":
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("--%s must be a number", p.Name)
+		}
+	case "url":
+		if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("--%s must be a url", p.Name)
+		}
+	}
+
+	if len(p.Allowed) > 0 {
+		for _, a := range p.Allowed {
+			if value == a {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("--%s must be one of: %s", p.Name, strings.Join(p.Allowed, ", "))
+	}
+
+	return nil
+}
+
+func (ts ServiceTypes) Len() int           { return len(ts) }
+func (ts ServiceTypes) Less(i, j int) bool { return ts[i].Name < ts[j].Name }
+func (ts ServiceTypes) Swap(i, j int)      { ts[i], ts[j] = ts[j], ts[i] }
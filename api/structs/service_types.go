@@ -0,0 +1,213 @@
+package structs
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// the service types every rack supports
+func init() {
+	private := ServiceParameter{Name: "private", Type: "boolean", Default: "false", Description: "Create in private subnets"}
+
+	RegisterServiceType(ServiceType{
+		Name:        "elasticsearch",
+		Description: "Elasticsearch Service domain",
+		Link:        "set",
+		Parameters: []ServiceParameter{
+			{Name: "instance-count", Type: "number", Default: "1", Description: "The number of data nodes in the domain"},
+			{Name: "instance-type", Type: "string", Default: "t2.small.elasticsearch", Description: "Instance type for data nodes"},
+			private,
+			{Name: "version", Type: "string", Default: "5.5", Description: "Elasticsearch version"},
+			{Name: "volume-size", Type: "number", Default: "10", Description: "EBS volume size for each data node (GB)"},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return fmt.Sprintf("https://%s:%s", outputs["Port443TcpAddr"], outputs["Port443TcpPort"])
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "external",
+		Description: "Existing resource outside the rack, e.g. an RDS instance or S3 bucket",
+		Link:        "set",
+		Provider:    true,
+		Parameters: []ServiceParameter{
+			{Name: "password", Type: "string", Description: "Password added to the URL, stored encrypted", Secret: true},
+			{Name: "url", Type: "url", Description: "URL of the resource, e.g. postgres://db.example.org:5432/app", Required: true},
+			{Name: "username", Type: "string", Description: "Username added to the URL, stored encrypted"},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return parameters["Url"]
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "memcached",
+		Description: "ElastiCache Memcached cluster",
+		Link:        "set",
+		Parameters: []ServiceParameter{
+			{Name: "instance-type", Type: "string", Default: "cache.t2.micro", Description: "The type of instance to use"},
+			{Name: "num-cache-nodes", Type: "number", Default: "1", Description: "The number of cache nodes in the cluster"},
+			private,
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return fmt.Sprintf("memcached://%s:%s", outputs["Port11211TcpAddr"], outputs["Port11211TcpPort"])
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "mysql",
+		Description: "RDS MySQL database",
+		Backups:     true,
+		Parameters: []ServiceParameter{
+			{Name: "allocated-storage", Type: "number", Default: "10", Description: "Allocated storage size (GB)"},
+			{Name: "database", Type: "string", Default: "app", Description: "Default database name"},
+			{Name: "instance-type", Type: "string", Default: "db.t2.micro", Description: "Instance class for database nodes"},
+			{Name: "multi-az", Type: "boolean", Default: "false", Description: "Multiple availability zone"},
+			{Name: "password", Type: "string", Description: "Server password, generated if not given", Secret: true},
+			private,
+			{Name: "username", Type: "string", Default: "app", Description: "Server username"},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return fmt.Sprintf("mysql://%s:%s@%s:%s/%s", outputs["EnvMysqlUsername"], outputs["EnvMysqlPassword"], outputs["Port3306TcpAddr"], outputs["Port3306TcpPort"], outputs["EnvMysqlDatabase"])
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "papertrail",
+		Provider:    true,
+		Unsupported: "papertrail is no longer supported. Create a `syslog` service instead",
+		URL: func(outputs, parameters map[string]string) string {
+			return parameters["Url"]
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "postgres",
+		Description: "RDS PostgreSQL database",
+		Backups:     true,
+		Link:        "replace",
+		Parameters: []ServiceParameter{
+			{Name: "allocated-storage", Type: "number", Default: "10", Description: "Allocated storage size (GB)"},
+			{Name: "database", Type: "string", Default: "app", Description: "Default database name"},
+			{Name: "instance-type", Type: "string", Default: "db.t2.micro", Description: "Instance class for database nodes"},
+			{Name: "max-connections", Type: "string", Description: "ParameterGroup max_connections value, i.e. '{DBInstanceClassMemory/15000000}'"},
+			{Name: "multi-az", Type: "boolean", Default: "false", Description: "Multiple availability zone"},
+			{Name: "password", Type: "string", Description: "Server password, generated if not given", Secret: true},
+			private,
+			{Name: "username", Type: "string", Default: "postgres", Description: "Server username"},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return fmt.Sprintf("postgres://%s:%s@%s:%s/%s", outputs["EnvPostgresUsername"], outputs["EnvPostgresPassword"], outputs["Port5432TcpAddr"], outputs["Port5432TcpPort"], outputs["EnvPostgresDatabase"])
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "rabbitmq",
+		Description: "Amazon MQ RabbitMQ broker",
+		Link:        "set",
+		Parameters: []ServiceParameter{
+			{Name: "instance-type", Type: "string", Default: "mq.t3.micro", Description: "Instance type for broker nodes"},
+			{Name: "multi-az", Type: "boolean", Default: "false", Description: "Run a cluster of brokers across availability zones"},
+			{Name: "password", Type: "string", Description: "Broker password, generated if not given", Secret: true},
+			private,
+			{Name: "username", Type: "string", Default: "app", Description: "Broker username"},
+			{Name: "version", Type: "string", Default: "3.8.6", Description: "RabbitMQ version"},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			u, err := url.Parse(outputs["AmqpEndpoint"])
+			if err != nil {
+				return ""
+			}
+
+			u.User = url.UserPassword(outputs["EnvBrokerUsername"], outputs["EnvBrokerPassword"])
+
+			return u.String()
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "redis",
+		Description: "ElastiCache Redis replication group",
+		Parameters: []ServiceParameter{
+			{Name: "automatic-failover-enabled", Type: "boolean", Default: "false", Description: "Multi-AZ failover, needs an instance type of cache.m3.medium or higher and 2 or more cache clusters"},
+			{Name: "database", Type: "string", Default: "0", Description: "Default database index"},
+			{Name: "instance-type", Type: "string", Default: "cache.t2.micro", Description: "The type of instance to use"},
+			{Name: "num-cache-clusters", Type: "number", Default: "1", Description: "The number of cache clusters for this replication group"},
+			private,
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return fmt.Sprintf("redis://%s:%s/%s", outputs["Port6379TcpAddr"], outputs["Port6379TcpPort"], outputs["EnvRedisDatabase"])
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "s3",
+		Description: "S3 bucket with an IAM user",
+		Link:        "set",
+		Parameters: []ServiceParameter{
+			{Name: "topic", Type: "string", Description: "Name of an sns service to notify of bucket changes"},
+			{Name: "versioning", Type: "boolean", Default: "false", Description: "Enable versioning"},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return fmt.Sprintf("s3://%s:%s@%s", outputs["AccessKey"], url.QueryEscape(outputs["SecretAccessKey"]), outputs["Bucket"])
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "sns",
+		Description: "SNS topic with an IAM user",
+		Link:        "set",
+		Parameters: []ServiceParameter{
+			{Name: "queue", Type: "string", Description: "Name of an sqs service to subscribe to the topic"},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return fmt.Sprintf("sns://%s:%s@%s", outputs["AccessKey"], url.QueryEscape(outputs["SecretAccessKey"]), outputs["Topic"])
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "sqs",
+		Description: "SQS queue with an IAM user",
+		Link:        "set",
+		URL: func(outputs, parameters map[string]string) string {
+			u, err := url.Parse(outputs["Queue"])
+			if err != nil {
+				return ""
+			}
+
+			u.Scheme = "sqs"
+			u.User = url.UserPassword(outputs["AccessKey"], url.QueryEscape(outputs["SecretAccessKey"]))
+
+			return u.String()
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "syslog",
+		Description: "Forward app logs to a syslog server",
+		Link:        "subscribe",
+		Provider:    true,
+		Parameters: []ServiceParameter{
+			{Name: "url", Type: "url", Description: "Syslog server, e.g. tcp+tls://logs1.papertrailapp.com:11235", Required: true},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			return parameters["Url"]
+		},
+	})
+
+	RegisterServiceType(ServiceType{
+		Name:        "webhook",
+		Description: "Post rack notifications to a URL",
+		Parameters: []ServiceParameter{
+			{Name: "url", Type: "url", Description: "Webhook URL, e.g. https://console.convox.com/webhooks/1234", Required: true},
+		},
+		URL: func(outputs, parameters map[string]string) string {
+			u, err := url.Parse(parameters["Url"])
+			if err != nil {
+				return ""
+			}
+
+			return u.Query().Get("endpoint")
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package workers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/convox/rack/api/helpers"
+	"github.com/convox/rack/api/models"
+	"github.com/convox/rack/api/provider"
+	"github.com/convox/rack/api/structs"
+	"github.com/ddollar/logger"
+)
+
+// Check the health of running services.
+// Notify when a service becomes unhealthy and when it recovers
+func StartServicesHealth() {
+	log := logger.New("ns=services_health")
+
+	defer recoverWith(func(err error) {
+		helpers.Error(log, err)
+	})
+
+	for _ = range time.Tick(5 * time.Minute) {
+		checkServicesHealth()
+	}
+}
+
+func checkServicesHealth() {
+	log := logger.New("ns=services_health")
+
+	services, err := models.ListServices()
+	if err != nil {
+		log.Log("fn=checkServicesHealth err=%q", err)
+		return
+	}
+
+	for _, s := range services {
+		if s.Status != "running" {
+			continue
+		}
+
+		last, err := provider.ServiceHealthGet(s.Name)
+		if err != nil {
+			log.Log("fn=checkServicesHealth service=%s err=%q", s.Name, err)
+			continue
+		}
+
+		health, err := provider.ServiceHealthCheck(s.Name)
+		if err != nil {
+			log.Log("fn=checkServicesHealth service=%s err=%q", s.Name, err)
+			continue
+		}
+
+		log.Log("fn=checkServicesHealth service=%s status=%s", s.Name, health.Status)
+
+		data := map[string]string{
+			"name": s.Name,
+			"type": s.Type,
+		}
+
+		switch healthEvent(last, health) {
+		case "service:unhealthy":
+			models.NotifyError("service:unhealthy", fmt.Errorf("%s", health.Reason), data)
+		case "service:recovered":
+			models.NotifySuccess("service:recovered", data)
+		}
+	}
+}
+
+// healthEvent returns the event for a change between the last and the current health of
+// a service, or "" when there is nothing to notify. A service that was never checked
+// counts as healthy.
+func healthEvent(last, health *structs.ServiceHealth) string {
+	switch {
+	case !health.Healthy() && (last == nil || last.Healthy()):
+		return "service:unhealthy"
+	case health.Healthy() && last != nil && !last.Healthy():
+		return "service:recovered"
+	}
+
+	return ""
+}
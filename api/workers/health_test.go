@@ -0,0 +1,20 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/convox/rack/api/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthEvent(t *testing.T) {
+	healthy := &structs.ServiceHealth{Status: "healthy"}
+	unhealthy := &structs.ServiceHealth{Status: "unhealthy", Reason: "db:5432 unreachable"}
+
+	assert.Equal(t, "", healthEvent(nil, healthy))
+	assert.Equal(t, "service:unhealthy", healthEvent(nil, unhealthy))
+	assert.Equal(t, "", healthEvent(healthy, healthy))
+	assert.Equal(t, "service:unhealthy", healthEvent(healthy, unhealthy))
+	assert.Equal(t, "", healthEvent(unhealthy, unhealthy))
+	assert.Equal(t, "service:recovered", healthEvent(unhealthy, healthy))
+}
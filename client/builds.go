@@ -15,6 +15,13 @@ type Build struct {
 	Release  string `json:"release"`
 	Status   string `json:"status"`
 
+	Author string `json:"author"`
+	Branch string `json:"branch"`
+	Commit string `json:"commit"`
+
+	BuilderVersion string                `json:"builder-version"`
+	Images         map[string]BuildImage `json:"images"`
+
 	Description string `json:"description"`
 
 	Started time.Time `json:"started"`
@@ -23,6 +30,16 @@ type Build struct {
 
 type Builds []Build
 
+type BuildImage struct {
+	Digest string `json:"digest"`
+	Size   int64  `json:"size"`
+}
+
+type BuildCredentials struct {
+	SSHKey string `json:"ssh-key"`
+	Token  string `json:"token"`
+}
+
 func (c *Client) GetBuilds(app string) (Builds, error) {
 	var builds Builds
 
@@ -91,7 +108,9 @@ func (c *Client) CreateBuildSource(app string, source []byte, cache bool, manife
 	return &build, nil
 }
 
-func (c *Client) CreateBuildUrl(app string, url string, cache bool, manifest string, description string) (*Build, error) {
+// CreateBuildUrl builds from a git repository url. ref optionally selects the branch, tag or
+// commit to build and dir the subdirectory of the repository to build from.
+func (c *Client) CreateBuildUrl(app string, url, ref, dir string, cache bool, manifest string, description string) (*Build, error) {
 	var build Build
 
 	params := map[string]string{
@@ -101,6 +120,14 @@ func (c *Client) CreateBuildUrl(app string, url string, cache bool, manifest str
 		"manifest":    manifest,
 	}
 
+	if ref != "" {
+		params["ref"] = ref
+	}
+
+	if dir != "" {
+		params["dir"] = dir
+	}
+
 	err := c.Post(fmt.Sprintf("/apps/%s/builds", app), params, &build)
 
 	if err != nil {
@@ -156,6 +183,11 @@ func (c *Client) UpdateBuild(app, id, manifest, status, reason string) (*Build,
 		"reason":   reason,
 	}
 
+	return c.UpdateBuildParams(app, id, params)
+}
+
+// UpdateBuildParams sets the given build attributes, e.g. commit
+func (c *Client) UpdateBuildParams(app, id string, params Params) (*Build, error) {
 	var build Build
 
 	err := c.Put(fmt.Sprintf("/apps/%s/builds/%s", app, id), params, &build)
@@ -165,3 +197,41 @@ func (c *Client) UpdateBuild(app, id, manifest, status, reason string) (*Build,
 
 	return &build, nil
 }
+
+func (c *Client) GetBuildCredentials(app string) (*BuildCredentials, error) {
+	var creds BuildCredentials
+
+	err := c.Get(fmt.Sprintf("/apps/%s/build-credentials", app), &creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &creds, nil
+}
+
+func (c *Client) SetBuildCredentials(app, sshKey, token string) (*BuildCredentials, error) {
+	var creds BuildCredentials
+
+	params := Params{
+		"ssh-key": sshKey,
+		"token":   token,
+	}
+
+	err := c.Post(fmt.Sprintf("/apps/%s/build-credentials", app), params, &creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &creds, nil
+}
+
+func (c *Client) DeleteBuildCredentials(app string) (*BuildCredentials, error) {
+	var creds BuildCredentials
+
+	err := c.Delete(fmt.Sprintf("/apps/%s/build-credentials", app), &creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &creds, nil
+}
@@ -42,3 +42,39 @@ func (c *Client) DeleteEnvironment(app, key string) (Environment, string, error)
 
 	return env, res.Header.Get("Release-Id"), nil
 }
+
+func (c *Client) GetBuildSecrets(app string) (Environment, error) {
+	var env Environment
+
+	err := c.Get(fmt.Sprintf("/apps/%s/build-secrets", app), &env)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
+
+func (c *Client) SetBuildSecrets(app string, body io.Reader) (Environment, error) {
+	var env Environment
+
+	err := c.PostBody(fmt.Sprintf("/apps/%s/build-secrets", app), body, &env)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
+
+func (c *Client) DeleteBuildSecret(app, key string) (Environment, error) {
+	var env Environment
+
+	err := c.Delete(fmt.Sprintf("/apps/%s/build-secrets/%s", app, key), &env)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
@@ -14,6 +14,9 @@ type IndexItem struct {
 	Mode    os.FileMode `json:"mode"`
 	ModTime time.Time   `json:"mtime"`
 	Size    int         `json:"-"`
+
+	// Data replaces the contents of the file on disk when uploading
+	Data []byte `json:"-"`
 }
 
 func (c *Client) IndexMissing(index Index) ([]string, error) {
@@ -45,3 +48,27 @@ func (c *Client) IndexUpload(hash string, data []byte) error {
 
 	return c.PostMultipart(fmt.Sprintf("/index/file/%s", hash), files, nil, nil)
 }
+
+type IndexStats struct {
+	Builds int `json:"builds"`
+
+	Blobs             int   `json:"blobs"`
+	ReferencedBlobs   int   `json:"referenced-blobs"`
+	UnreferencedBlobs int   `json:"unreferenced-blobs"`
+	StoredBytes       int64 `json:"stored-bytes"`
+
+	LogicalBytes int64   `json:"logical-bytes"`
+	UniqueBytes  int64   `json:"unique-bytes"`
+	DedupeRatio  float64 `json:"dedupe-ratio"`
+}
+
+func (c *Client) IndexStats() (*IndexStats, error) {
+	var stats IndexStats
+
+	err := c.Get("/index/stats", &stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
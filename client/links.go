@@ -1,12 +1,59 @@
 package client
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+)
 
-func (c *Client) CreateLink(app, name string) (*Service, error) {
+// Link is a service linked to an app through an environment variable
+type Link struct {
+	App      string `json:"app"`
+	Service  string `json:"service"`
+	Process  string `json:"process"`
+	Variable string `json:"variable"`
+}
+
+type Links []Link
+
+func (ls Links) Len() int      { return len(ls) }
+func (ls Links) Swap(i, j int) { ls[i], ls[j] = ls[j], ls[i] }
+
+// Less orders links by app and then by process
+func (ls Links) Less(i, j int) bool {
+	if ls[i].App == ls[j].App {
+		return ls[i].Process < ls[j].Process
+	}
+
+	return ls[i].App < ls[j].App
+}
+
+func (c *Client) GetLinks(app string) (Links, error) {
+	var links Links
+
+	err := c.Get(fmt.Sprintf("/apps/%s/links", app), &links)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// CreateLink links a service to an app, or only to one of its processes. Services linked
+// through the environment set <PREFIX>_URL, with a prefix defaulting to the service name.
+func (c *Client) CreateLink(app, name, process, prefix string) (*Service, error) {
 	params := Params{
 		"app": app,
 	}
 
+	if process != "" {
+		params["process"] = process
+	}
+
+	if prefix != "" {
+		params["as"] = prefix
+	}
+
 	var service Service
 
 	err := c.Post(fmt.Sprintf("/services/%s/links", name), params, &service)
@@ -18,10 +65,16 @@ func (c *Client) CreateLink(app, name string) (*Service, error) {
 	return &service, nil
 }
 
-func (c *Client) DeleteLink(app, name string) (*Service, error) {
+func (c *Client) DeleteLink(app, name, process string) (*Service, error) {
 	var service Service
 
-	err := c.Delete(fmt.Sprintf("/services/%s/links/%s", name, app), &service)
+	path := fmt.Sprintf("/services/%s/links/%s", name, app)
+
+	if process != "" {
+		path += "?process=" + url.QueryEscape(process)
+	}
+
+	err := c.Delete(path, &service)
 
 	if err != nil {
 		return nil, err
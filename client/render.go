@@ -0,0 +1,27 @@
+package client
+
+import "fmt"
+
+// ManifestRender is the template and parameters a manifest would apply to an app
+type ManifestRender struct {
+	Template   string            `json:"template"`
+	Parameters map[string]string `json:"parameters"`
+	Current    string            `json:"current"`
+}
+
+// RenderManifest renders a manifest against an app without releasing it
+func (c *Client) RenderManifest(app, manifest string) (*ManifestRender, error) {
+	var render ManifestRender
+
+	params := Params{
+		"manifest": manifest,
+	}
+
+	err := c.Post(fmt.Sprintf("/apps/%s/render", app), params, &render)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &render, nil
+}
@@ -1,6 +1,14 @@
 package client
 
-import "fmt"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+)
 
 type Service struct {
 	Name         string            `json:"name"`
@@ -8,6 +16,8 @@ type Service struct {
 	StatusReason string            `json:"status-reason"`
 	Type         string            `json:"type"`
 	Exports      map[string]string `json:"exports"`
+	Health       *ServiceHealth    `json:"health,omitempty"`
+	Links        Links             `json:"links,omitempty"`
 	// DEPRECATED: should inject any data in Exports
 	// we only set this on the outgoing response for old clients
 	URL string `json:"url"`
@@ -19,6 +29,60 @@ type Service struct {
 
 type Services []Service
 
+// ServiceHealth is the result of the latest health check of a service
+type ServiceHealth struct {
+	Status  string             `json:"status"`
+	Reason  string             `json:"reason,omitempty"`
+	Checked time.Time          `json:"checked"`
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+}
+
+// ServiceType is a kind of service the rack can create and its options
+type ServiceType struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Parameters  []ServiceParameter `json:"parameters"`
+	Link        string             `json:"link"`
+	Backups     bool               `json:"backups"`
+}
+
+type ServiceTypes []ServiceType
+
+// ServiceChange is a change to a resource of a service that an update would make
+type ServiceChange struct {
+	Action      string `json:"action"`
+	Resource    string `json:"resource"`
+	Type        string `json:"type"`
+	Replacement string `json:"replacement"`
+
+	// Stateful resources lose their data when replaced
+	Stateful bool `json:"stateful"`
+}
+
+type ServiceChanges []ServiceChange
+
+// ServiceBackup is a snapshot of the data of a service
+type ServiceBackup struct {
+	Id      string    `json:"id"`
+	Service string    `json:"service"`
+	Status  string    `json:"status"`
+	Size    int64     `json:"size"`
+	Created time.Time `json:"created"`
+}
+
+type ServiceBackups []ServiceBackup
+
+// ServiceParameter is an option of a service type
+type ServiceParameter struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Default     string   `json:"default"`
+	Allowed     []string `json:"allowed"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required"`
+	Secret      bool     `json:"secret"`
+}
+
 func (c *Client) GetServices() (Services, error) {
 	var services Services
 
@@ -57,10 +121,18 @@ func (c *Client) GetService(name string) (*Service, error) {
 	return &service, nil
 }
 
-func (c *Client) DeleteService(name string) (*Service, error) {
+// DeleteService deletes a service. Database services without a final snapshot are only
+// deleted when forced.
+func (c *Client) DeleteService(name string, force bool) (*Service, error) {
 	var service Service
 
-	err := c.Delete(fmt.Sprintf("/services/%s", name), &service)
+	path := fmt.Sprintf("/services/%s", name)
+
+	if force {
+		path += "?force=true"
+	}
+
+	err := c.Delete(path, &service)
 
 	if err != nil {
 		return nil, err
@@ -81,3 +153,186 @@ func (c *Client) UpdateService(name string, options map[string]string) (*Service
 
 	return &service, nil
 }
+
+// PreviewServiceUpdate returns the changes an update would make without applying them
+func (c *Client) PreviewServiceUpdate(name string, options map[string]string) (ServiceChanges, error) {
+	params := Params(options)
+	params["dry-run"] = "true"
+
+	var changes ServiceChanges
+
+	err := c.Put(fmt.Sprintf("/services/%s", name), params, &changes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// GetServiceDependents returns the links of apps to a service
+func (c *Client) GetServiceDependents(name string) (Links, error) {
+	var links Links
+
+	err := c.Get(fmt.Sprintf("/services/%s/dependents", name), &links)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (c *Client) GetServiceTypes() (ServiceTypes, error) {
+	var types ServiceTypes
+
+	err := c.Get("/services/types", &types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return types, nil
+}
+
+func (c *Client) CreateServiceBackup(name string) (*ServiceBackup, error) {
+	var backup ServiceBackup
+
+	err := c.Post(fmt.Sprintf("/services/%s/backups", name), Params{}, &backup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &backup, nil
+}
+
+func (c *Client) GetServiceBackups(name string) (ServiceBackups, error) {
+	var backups ServiceBackups
+
+	err := c.Get(fmt.Sprintf("/services/%s/backups", name), &backups)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return backups, nil
+}
+
+func (c *Client) RestoreServiceBackup(name, backup string) (*Service, error) {
+	var service Service
+
+	err := c.Post(fmt.Sprintf("/services/%s/backups/%s/restore", name, backup), Params{}, &service)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+// CloneServiceBackup creates a service named clone from a backup of a service
+func (c *Client) CloneServiceBackup(name, backup, clone string) (*Service, error) {
+	var service Service
+
+	params := Params{
+		"name": clone,
+	}
+
+	err := c.Post(fmt.Sprintf("/services/%s/backups/%s/clone", name, backup), params, &service)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+// RotateServiceCredentials replaces the password of a service and updates the apps
+// linked to it, promoting their new releases if promote is true
+func (c *Client) RotateServiceCredentials(name string, promote bool) (*Service, error) {
+	var service Service
+
+	params := Params{}
+
+	if promote {
+		params["promote"] = "true"
+	}
+
+	err := c.Post(fmt.Sprintf("/services/%s/rotate-credentials", name), params, &service)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+// ExportService writes a dump of the database of a service to out
+func (c *Client) ExportService(name string, out io.WriteCloser) error {
+	return c.streamService(fmt.Sprintf("/services/%s/export", name), nil, out)
+}
+
+// ImportService loads a dump read from in into the database of a service
+func (c *Client) ImportService(name string, in io.Reader, out io.WriteCloser) error {
+	// a websocket can not be half closed so the end of the input is marked instead
+	in = io.MultiReader(in, strings.NewReader(StatusCodePrefix))
+
+	return c.streamService(fmt.Sprintf("/services/%s/import", name), in, out)
+}
+
+func (c *Client) streamService(path string, in io.Reader, out io.WriteCloser) error {
+	r, w := io.Pipe()
+
+	defer r.Close()
+	defer w.Close()
+
+	ch := make(chan error, 1)
+
+	go copyServiceStream(out, r, ch)
+
+	if err := c.Stream(path, nil, in, w); err != nil {
+		return err
+	}
+
+	return <-ch
+}
+
+// copyServiceStream copies a service stream until its exit status, unlike copyWithExit
+// it leaves the terminal alone and returns errors instead of writing them to w
+func copyServiceStream(w io.Writer, r io.Reader, ch chan error) {
+	buf := make([]byte, 32*1024)
+
+	for {
+		n, err := r.Read(buf)
+
+		if err == io.EOF {
+			ch <- fmt.Errorf("stream closed before completion")
+			return
+		}
+
+		if err != nil {
+			ch <- err
+			return
+		}
+
+		data := buf[0:n]
+
+		if bytes.HasPrefix(data, []byte(StatusCodePrefix)) {
+			ch <- nil
+			break
+		}
+
+		if bytes.HasPrefix(data, []byte("ERROR: ")) {
+			ch <- errors.New(strings.TrimSpace(string(data[7:])))
+			break
+		}
+
+		if _, err := w.Write(data); err != nil {
+			ch <- err
+			break
+		}
+	}
+
+	io.Copy(ioutil.Discard, r)
+}
@@ -19,6 +19,19 @@ type SystemCapacity struct {
 	ProcessWidth   int64 `json:"process-width"`
 }
 
+// SystemGraph is the dependency graph of a rack: its apps, services and the links of
+// apps to services
+type SystemGraph struct {
+	Apps     []string             `json:"apps"`
+	Services []SystemGraphService `json:"services"`
+	Links    Links                `json:"links"`
+}
+
+type SystemGraphService struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
 func (c *Client) GetSystem() (*System, error) {
 	var system System
 
@@ -60,6 +73,18 @@ func (c *Client) GetSystemCapacity() (*SystemCapacity, error) {
 	return &capacity, nil
 }
 
+func (c *Client) GetSystemGraph() (*SystemGraph, error) {
+	var graph SystemGraph
+
+	err := c.Get("/system/graph", &graph)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &graph, nil
+}
+
 func (c *Client) GetSystemReleases() (Releases, error) {
 	var releases Releases
 
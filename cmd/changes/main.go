@@ -3,83 +3,26 @@ package main
 import (
 	"fmt"
 	"os"
-	"path/filepath"
-	"time"
-)
 
-var files = map[string]map[string]time.Time{}
+	"github.com/convox/rack/api/fswatch"
+)
 
 func main() {
-	for _, watch := range os.Args {
-		watchDirectory(watch)
-	}
-
-	// block forever
-	select {}
-}
-
-func watchDirectory(dir string) error {
-	abs, err := filepath.Abs(dir)
+	ch := make(chan []fswatch.Change)
 
-	if err != nil {
-		return err
+	for _, dir := range os.Args[1:] {
+		go watchDirectory(dir, ch)
 	}
 
-	sym, err := filepath.EvalSymlinks(abs)
-
-	if err != nil {
-		return err
-	}
-
-	files[sym] = map[string]time.Time{}
-
-	err = filepath.Walk(sym, func(path string, info os.FileInfo, err error) error {
-		if path == sym {
-			return nil
-		}
-
-		if info != nil {
-			files[sym][path] = info.ModTime()
+	for changes := range ch {
+		for _, c := range changes {
+			fmt.Println(c.String())
 		}
-
-		return nil
-	})
-
-	if err != nil {
-		return err
 	}
-
-	go watchForChanges(sym)
-
-	return nil
 }
 
-func watchForChanges(dir string) {
-	for {
-		for file, _ := range files[dir] {
-			if _, err := os.Stat(file); os.IsNotExist(err) {
-				rel, _ := filepath.Rel(dir, file)
-				delete(files[dir], file)
-				fmt.Printf("delete|%s|%s\n", dir, rel)
-			}
-		}
-
-		filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-			if path == dir {
-				return nil
-			}
-
-			e, ok := files[dir][path]
-
-			if !ok || e.Before(info.ModTime()) && !info.IsDir() {
-				rel, _ := filepath.Rel(dir, path)
-				files[dir][path] = info.ModTime()
-				fmt.Printf("add|%s|%s\n", dir, rel)
-			}
-
-			return nil
-		})
-
-		time.Sleep(900 * time.Millisecond)
+func watchDirectory(dir string, ch chan []fswatch.Change) {
+	if err := fswatch.Watch(dir, fswatch.Options{}, ch); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
 	}
 }
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -10,16 +13,20 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"gopkg.in/urfave/cli.v1"
 
 	"github.com/cheggaaa/pb"
+	"github.com/convox/rack/api/manifest"
 	"github.com/convox/rack/client"
 	"github.com/convox/rack/cmd/convox/stdcli"
 	"github.com/docker/docker/builder/dockerignore"
 	"github.com/docker/docker/pkg/archive"
 	"github.com/docker/docker/pkg/fileutils"
+	"github.com/dustin/go-humanize"
 )
 
 var (
@@ -46,6 +53,16 @@ var (
 			Value: "",
 			Usage: "description of the build",
 		},
+		cli.StringFlag{
+			Name:  "ref",
+			Value: "",
+			Usage: "git branch, tag or commit to build when building from a repository url",
+		},
+		cli.StringFlag{
+			Name:  "dir",
+			Value: "",
+			Usage: "subdirectory of the repository to build from",
+		},
 	}
 )
 
@@ -99,6 +116,77 @@ func init() {
 				Action:      cmdBuildsDelete,
 				Flags:       []cli.Flag{appFlag, rackFlag},
 			},
+			{
+				Name:        "secrets",
+				Description: "list build secrets for an app",
+				Usage:       "",
+				Action:      cmdBuildsSecrets,
+				Flags:       []cli.Flag{appFlag, rackFlag},
+				Subcommands: []cli.Command{
+					{
+						Name:        "set",
+						Description: "set build secrets passed to builds as build args",
+						Usage:       "NAME=VALUE [NAME=VALUE]",
+						Action:      cmdBuildsSecretsSet,
+						Flags:       []cli.Flag{appFlag, rackFlag},
+					},
+					{
+						Name:        "unset",
+						Description: "delete a build secret",
+						Usage:       "NAME",
+						Action:      cmdBuildsSecretsUnset,
+						Flags:       []cli.Flag{appFlag, rackFlag},
+					},
+				},
+			},
+			{
+				Name:        "index",
+				Description: "manage the incremental build index",
+				Usage:       "",
+				Subcommands: []cli.Command{
+					{
+						Name:        "stats",
+						Description: "show storage used by the incremental build index",
+						Usage:       "",
+						Action:      cmdBuildsIndexStats,
+						Flags:       []cli.Flag{rackFlag},
+					},
+				},
+			},
+			{
+				Name:        "credentials",
+				Description: "show repository credentials used for builds from private git urls",
+				Usage:       "",
+				Action:      cmdBuildsCredentials,
+				Flags:       []cli.Flag{appFlag, rackFlag},
+				Subcommands: []cli.Command{
+					{
+						Name:        "set",
+						Description: "set repository credentials for builds",
+						Usage:       "[--ssh-key FILE] [--token TOKEN]",
+						Action:      cmdBuildsCredentialsSet,
+						Flags: []cli.Flag{
+							appFlag,
+							rackFlag,
+							cli.StringFlag{
+								Name:  "ssh-key",
+								Usage: "path to a private deploy key for ssh repository urls",
+							},
+							cli.StringFlag{
+								Name:  "token",
+								Usage: "access token for https repository urls",
+							},
+						},
+					},
+					{
+						Name:        "unset",
+						Description: "remove repository credentials",
+						Usage:       "",
+						Action:      cmdBuildsCredentialsUnset,
+						Flags:       []cli.Flag{appFlag, rackFlag},
+					},
+				},
+			},
 		},
 	})
 }
@@ -218,10 +306,246 @@ func cmdBuildsInfo(c *cli.Context) error {
 		return stdcli.ExitError(err)
 	}
 
+	fmt.Printf("Id           %s\n", b.Id)
+	fmt.Printf("Status       %s\n", b.Status)
+	fmt.Printf("Release      %s\n", b.Release)
+	fmt.Printf("Description  %s\n", b.Description)
+	fmt.Printf("Started      %s\n", humanizeTime(b.Started))
+	fmt.Printf("Elapsed      %s\n", stdcli.Duration(b.Started, b.Ended))
+
+	if b.Commit != "" {
+		fmt.Printf("Commit       %s\n", b.Commit)
+		fmt.Printf("Branch       %s\n", b.Branch)
+		fmt.Printf("Author       %s\n", b.Author)
+	}
+
+	if b.BuilderVersion != "" {
+		fmt.Printf("Builder      %s\n", b.BuilderVersion)
+	}
+
+	if len(b.Images) > 0 {
+		names := []string{}
+
+		for name := range b.Images {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		fmt.Println()
+
+		t := stdcli.NewTable("SERVICE", "DIGEST", "SIZE")
+
+		for _, name := range names {
+			image := b.Images[name]
+			t.AddRow(name, image.Digest, humanize.Bytes(uint64(image.Size)))
+		}
+
+		t.Print()
+	}
+
+	fmt.Println()
 	fmt.Println(b.Logs)
 	return nil
 }
 
+func cmdBuildsSecrets(c *cli.Context) error {
+	_, app, err := stdcli.DirApp(c, ".")
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if len(c.Args()) > 0 {
+		return stdcli.ExitError(fmt.Errorf("`convox builds secrets` does not take arguments. Perhaps you meant `convox builds secrets set`?"))
+	}
+
+	secrets, err := rackClient(c).GetBuildSecrets(app)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	keys := []string{}
+
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	// only print names, values stay secret
+	for _, key := range keys {
+		fmt.Println(key)
+	}
+
+	return nil
+}
+
+func cmdBuildsSecretsSet(c *cli.Context) error {
+	_, app, err := stdcli.DirApp(c, ".")
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	data := ""
+
+	stat, err := os.Stdin.Stat()
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if (stat.Mode() & os.ModeCharDevice) == 0 {
+		in, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return stdcli.ExitError(err)
+		}
+
+		data += string(in)
+	}
+
+	for _, value := range c.Args() {
+		data += fmt.Sprintf("%s\n", value)
+	}
+
+	if data == "" {
+		stdcli.Usage(c, "set")
+		return nil
+	}
+
+	fmt.Print("Updating build secrets... ")
+
+	_, err = rackClient(c).SetBuildSecrets(app, strings.NewReader(data))
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Println("OK")
+	return nil
+}
+
+func cmdBuildsSecretsUnset(c *cli.Context) error {
+	_, app, err := stdcli.DirApp(c, ".")
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if len(c.Args()) != 1 {
+		stdcli.Usage(c, "unset")
+		return nil
+	}
+
+	fmt.Print("Updating build secrets... ")
+
+	_, err = rackClient(c).DeleteBuildSecret(app, c.Args()[0])
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Println("OK")
+	return nil
+}
+
+func cmdBuildsIndexStats(c *cli.Context) error {
+	if len(c.Args()) > 0 {
+		return stdcli.ExitError(fmt.Errorf("`convox builds index stats` does not take arguments."))
+	}
+
+	stats, err := rackClient(c).IndexStats()
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Printf("Builds        %d\n", stats.Builds)
+	fmt.Printf("Blobs         %d (%d unreferenced)\n", stats.Blobs, stats.UnreferencedBlobs)
+	fmt.Printf("Stored        %s\n", humanize.Bytes(uint64(stats.StoredBytes)))
+	fmt.Printf("Unique        %s\n", humanize.Bytes(uint64(stats.UniqueBytes)))
+	fmt.Printf("Logical       %s\n", humanize.Bytes(uint64(stats.LogicalBytes)))
+	fmt.Printf("Dedupe Ratio  %.2fx\n", stats.DedupeRatio)
+
+	return nil
+}
+
+func cmdBuildsCredentials(c *cli.Context) error {
+	_, app, err := stdcli.DirApp(c, ".")
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if len(c.Args()) > 0 {
+		return stdcli.ExitError(fmt.Errorf("`convox builds credentials` does not take arguments. Perhaps you meant `convox builds credentials set`?"))
+	}
+
+	creds, err := rackClient(c).GetBuildCredentials(app)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	// the rack redacts values, only show what is configured
+	fmt.Printf("SSH Key  %s\n", credentialStatus(creds.SSHKey))
+	fmt.Printf("Token    %s\n", credentialStatus(creds.Token))
+
+	return nil
+}
+
+func cmdBuildsCredentialsSet(c *cli.Context) error {
+	_, app, err := stdcli.DirApp(c, ".")
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	sshKey := ""
+
+	if file := c.String("ssh-key"); file != "" {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return stdcli.ExitError(err)
+		}
+
+		sshKey = string(data)
+	}
+
+	token := c.String("token")
+
+	if sshKey == "" && token == "" {
+		stdcli.Usage(c, "set")
+		return nil
+	}
+
+	fmt.Print("Updating build credentials... ")
+
+	_, err = rackClient(c).SetBuildCredentials(app, sshKey, token)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Println("OK")
+	return nil
+}
+
+func cmdBuildsCredentialsUnset(c *cli.Context) error {
+	_, app, err := stdcli.DirApp(c, ".")
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Print("Removing build credentials... ")
+
+	_, err = rackClient(c).DeleteBuildCredentials(app)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Println("OK")
+	return nil
+}
+
+func credentialStatus(value string) string {
+	if value == "" {
+		return "not set"
+	}
+
+	return "set"
+}
+
 func cmdBuildsCopy(c *cli.Context) error {
 	_, app, err := stdcli.DirApp(c, ".")
 	if err != nil {
@@ -425,17 +749,21 @@ func uploadIndex(c *cli.Context, index client.Index) error {
 }
 
 func uploadItem(c *cli.Context, hash string, item client.IndexItem, bar *pb.ProgressBar, ch chan error) {
-	data, err := ioutil.ReadFile(item.Name)
+	data := item.Data
 
-	if err != nil {
-		ch <- err
-		return
+	if data == nil {
+		d, err := ioutil.ReadFile(item.Name)
+
+		if err != nil {
+			ch <- err
+			return
+		}
+
+		data = d
 	}
 
 	for i := 0; i < 3; i++ {
-		err = rackClient(c).IndexUpload(hash, data)
-
-		if err != nil {
+		if err := rackClient(c).IndexUpload(hash, data); err != nil {
 			continue
 		}
 
@@ -483,6 +811,14 @@ func executeBuildDirIncremental(c *cli.Context, dir, app, manifest, description
 		return "", err
 	}
 
+	files, err := interpolateManifest(dir, manifest)
+
+	if err != nil {
+		return "", err
+	}
+
+	interpolateIndex(index, files)
+
 	fmt.Println("OK")
 
 	fmt.Printf("Uploading changes... ")
@@ -524,6 +860,16 @@ func executeBuildDir(c *cli.Context, dir, app, manifest, description string) (st
 		return "", err
 	}
 
+	files, err := interpolateManifest(dir, manifest)
+	if err != nil {
+		return "", err
+	}
+
+	tar, err = interpolateTarball(tar, files)
+	if err != nil {
+		return "", err
+	}
+
 	fmt.Println("OK")
 
 	cache := !c.Bool("no-cache")
@@ -544,7 +890,7 @@ func executeBuildDir(c *cli.Context, dir, app, manifest, description string) (st
 func executeBuildUrl(c *cli.Context, url, app, manifest, description string) (string, error) {
 	cache := !c.Bool("no-cache")
 
-	build, err := rackClient(c).CreateBuildUrl(app, url, cache, manifest, description)
+	build, err := rackClient(c).CreateBuildUrl(app, url, c.String("ref"), c.String("dir"), cache, manifest, description)
 
 	if err != nil {
 		return "", err
@@ -622,6 +968,99 @@ func createTarball(base string) ([]byte, error) {
 	return bytes, nil
 }
 
+// interpolateManifest returns the manifest files of dir with variables substituted.
+// Builds read manifests as written so variables are substituted before the upload.
+func interpolateManifest(dir, file string) (map[string][]byte, error) {
+	if file == "" {
+		file = "docker-compose.yml"
+	}
+
+	return manifest.Interpolate(dir, file)
+}
+
+// interpolateIndex replaces the index items of interpolated files
+func interpolateIndex(index client.Index, files map[string][]byte) {
+	items := []client.IndexItem{}
+
+	for hash, item := range index {
+		if data, ok := files[item.Name]; ok {
+			delete(index, hash)
+
+			item.Data = data
+			item.Size = len(data)
+
+			items = append(items, item)
+		}
+	}
+
+	for _, item := range items {
+		sum := sha256.Sum256(item.Data)
+		index[hex.EncodeToString(sum[:])] = item
+	}
+}
+
+// interpolateTarball replaces the contents of interpolated files in a gzipped tarball
+func interpolateTarball(data []byte, files map[string][]byte) ([]byte, error) {
+	if len(files) == 0 {
+		return data, nil
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	gzw := gzip.NewWriter(&buf)
+	tr := tar.NewReader(gz)
+	tw := tar.NewWriter(gzw)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		contents, ok := files[filepath.Clean(header.Name)]
+
+		if !ok {
+			if err := tw.WriteHeader(header); err != nil {
+				return nil, err
+			}
+
+			if _, err := io.Copy(tw, tr); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		header.Size = int64(len(contents))
+
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, err
+		}
+
+		if _, err := tw.Write(contents); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := gzw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 func finishBuild(c *cli.Context, app string, build *client.Build) (string, error) {
 	if build.Id == "" {
 		return "", fmt.Errorf("unable to fetch build id")
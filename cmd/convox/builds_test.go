@@ -1,6 +1,12 @@
 package main
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/convox/rack/client"
@@ -41,3 +47,128 @@ func TestBuildsCreateReturnsNoBuild(t *testing.T) {
 		},
 	)
 }
+
+func TestBuildsCreateRefDir(t *testing.T) {
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/apps/foo", Code: 200, Response: client.App{Name: "foo", Status: "running"}},
+		test.Http{Method: "POST", Path: "/apps/foo/builds", Body: "cache=true&description=&dir=services%2Fweb&manifest=docker-compose.yml&ref=v1.2&repo=https%3A%2F%2Fexample.org", Code: 200, Response: client.Build{}},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox build https://example.org --app foo --ref v1.2 --dir services/web",
+			Exit:    1,
+			Stdout:  "",
+			Stderr:  "ERROR: unable to fetch build id\n",
+		},
+	)
+}
+
+func TestBuildsInfo(t *testing.T) {
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/apps/foo/builds/BABC", Code: 200, Response: client.Build{
+			Id:             "BABC",
+			Status:         "complete",
+			Release:        "RABC",
+			Commit:         "0a1b2c",
+			Branch:         "master",
+			Author:         "Dev <dev@example.org>",
+			BuilderVersion: "20161019000000",
+			Images: map[string]client.BuildImage{
+				"web": client.BuildImage{Digest: "sha256:abc", Size: 2048},
+			},
+			Logs: "build output",
+		}},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox builds info BABC --app foo",
+			Exit:    0,
+			Stdout:  "Id           BABC\nStatus       complete\nRelease      RABC\nDescription  \nStarted      \nElapsed      0s\nCommit       0a1b2c\nBranch       master\nAuthor       Dev <dev@example.org>\nBuilder      20161019000000\n\nSERVICE  DIGEST      SIZE \nweb      sha256:abc  2.0kB\n\nbuild output\n",
+		},
+	)
+}
+
+func TestBuildsIndexStats(t *testing.T) {
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/index/stats", Code: 200, Response: client.IndexStats{
+			Builds:            3,
+			Blobs:             10,
+			UnreferencedBlobs: 2,
+			StoredBytes:       1000,
+			UniqueBytes:       4000,
+			LogicalBytes:      12000,
+			DedupeRatio:       3,
+		}},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox builds index stats",
+			Exit:    0,
+			Stdout:  "Builds        3\nBlobs         10 (2 unreferenced)\nStored        1.0kB\nUnique        4.0kB\nLogical       12kB\nDedupe Ratio  3.00x\n",
+		},
+	)
+}
+
+func TestBuildsInterpolateTarball(t *testing.T) {
+	dir, err := ioutil.TempDir("", "convox-build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.Setenv("BUILDS_TEST_IMAGE", "convox/web")
+	defer os.Unsetenv("BUILDS_TEST_IMAGE")
+
+	ioutil.WriteFile(filepath.Join(dir, "docker-compose.yml"), []byte("web:\n  image: ${BUILDS_TEST_IMAGE}\n"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM scratch\n"), 0644)
+
+	data, err := createTarball(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := interpolateManifest(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err = interpolateTarball(data, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contents := map[string]string{}
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+
+		body, _ := ioutil.ReadAll(tr)
+		contents[header.Name] = string(body)
+	}
+
+	if contents["docker-compose.yml"] != "web:\n  image: convox/web\n" {
+		t.Errorf("expected interpolated manifest, got: %q", contents["docker-compose.yml"])
+	}
+
+	if contents["Dockerfile"] != "FROM scratch\n" {
+		t.Errorf("expected Dockerfile to be unchanged, got: %q", contents["Dockerfile"])
+	}
+}
@@ -32,6 +32,19 @@ func humanizeBool(b bool) string {
 	}
 }
 
+// localEnvironment returns the local environment as a map
+func localEnvironment() map[string]string {
+	env := map[string]string{}
+
+	for _, e := range os.Environ() {
+		if parts := strings.SplitN(e, "=", 2); len(parts) == 2 {
+			env[parts[0]] = parts[1]
+		}
+	}
+
+	return env
+}
+
 func upperName(name string) string {
 	us := strings.ToUpper(name[0:1]) + name[1:]
 
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/convox/rack/api/manifest"
+	"github.com/convox/rack/cmd/convox/stdcli"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/urfave/cli.v1"
+)
+
+func init() {
+	stdcli.RegisterCommand(cli.Command{
+		Name:        "manifest",
+		Description: "inspect an app's manifest",
+		Usage:       "",
+		Action:      cmdManifest,
+		Subcommands: []cli.Command{
+			{
+				Name:        "lint",
+				Description: "check a manifest for problems",
+				Usage:       "[directory]",
+				Action:      cmdManifestLint,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "file, f",
+						Value: "docker-compose.yml",
+						Usage: "path to an alternate docker compose manifest file",
+					},
+					cli.StringFlag{
+						Name:  "format",
+						Value: "text",
+						Usage: "output format (text or json)",
+					},
+				},
+			},
+			{
+				Name:        "render",
+				Description: "render the template and parameters a manifest would apply to an app",
+				Usage:       "[directory]",
+				Action:      cmdManifestRender,
+				Flags: []cli.Flag{
+					appFlag,
+					rackFlag,
+					cli.StringFlag{
+						Name:  "file, f",
+						Value: "docker-compose.yml",
+						Usage: "path to an alternate docker compose manifest file",
+					},
+				},
+			},
+		},
+	})
+}
+
+func cmdManifest(c *cli.Context) error {
+	if len(c.Args()) > 0 {
+		return stdcli.ExitError(fmt.Errorf("`convox manifest` does not take arguments. Perhaps you meant `convox manifest lint` or `convox manifest render`?"))
+	}
+
+	stdcli.Usage(c, "")
+	return nil
+}
+
+func cmdManifestLint(c *cli.Context) error {
+	wd := "."
+
+	if len(c.Args()) > 0 {
+		wd = c.Args()[0]
+	}
+
+	issues, err := manifest.Lint(wd, c.String("file"))
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	switch c.String("format") {
+	case "json":
+		data, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return stdcli.ExitError(err)
+		}
+
+		fmt.Println(string(data))
+	case "text":
+		for _, issue := range issues {
+			fmt.Println(formatLintIssue(issue))
+		}
+	default:
+		return stdcli.ExitError(fmt.Errorf("unknown format: %s", c.String("format")))
+	}
+
+	errors := 0
+
+	for _, issue := range issues {
+		if issue.Severity == manifest.SeverityError {
+			errors++
+		}
+	}
+
+	if errors > 0 {
+		return cli.NewExitError("", 1)
+	}
+
+	return nil
+}
+
+// formatLintIssue renders an issue as file:line: severity: message
+func formatLintIssue(issue manifest.LintIssue) string {
+	location := issue.File
+
+	if issue.Line > 0 {
+		location = fmt.Sprintf("%s:%d", issue.File, issue.Line)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", location, issue.Severity, issue.Message)
+}
+
+func cmdManifestRender(c *cli.Context) error {
+	wd := "."
+
+	if len(c.Args()) > 0 {
+		wd = c.Args()[0]
+	}
+
+	dir, app, err := stdcli.DirApp(c, wd)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	// interpolate the manifest the same way a deploy does so the rack renders what it would build
+	m, err := manifest.ReadInterpolated(dir, c.String("file"))
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	data, err := m.Raw()
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	render, err := rackClient(c).RenderManifest(app, string(data))
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Println("# Template")
+	fmt.Println(render.Template)
+
+	fmt.Println()
+	fmt.Println("# Parameters")
+
+	keys := []string{}
+
+	for key := range render.Parameters {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Printf("%s=%s\n", key, render.Parameters[key])
+	}
+
+	fmt.Println()
+	fmt.Println("# Changes")
+
+	diff, err := templateDiff(render.Current, render.Template)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if diff == "" {
+		fmt.Println("no changes to the deployed template")
+		return nil
+	}
+
+	fmt.Print(diff)
+
+	return nil
+}
+
+// templateDiff returns a unified diff from the deployed template to a rendered one
+func templateDiff(current, rendered string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSpace(current)),
+		B:        difflib.SplitLines(strings.TrimSpace(rendered)),
+		FromFile: "deployed",
+		ToFile:   "rendered",
+		Context:  3,
+	})
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/convox/rack/api/manifest"
+	"github.com/convox/rack/client"
+	"github.com/convox/rack/test"
+)
+
+func manifestDir(t *testing.T, yml string) string {
+	dir, err := ioutil.TempDir("", "convox-manifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "docker-compose.yml"), []byte(yml), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func TestManifestLint(t *testing.T) {
+	dir := manifestDir(t, "web:\n  image: httpd\n  links:\n    - database\n")
+	defer os.RemoveAll(dir)
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox manifest lint",
+			Dir:     dir,
+			Exit:    1,
+			Stdout:  "docker-compose.yml:4: error: web: links to missing service database\n",
+		},
+		test.ExecRun{
+			Command: "convox manifest lint --format json",
+			Dir:     dir,
+			Exit:    1,
+			Stdout:  "[\n  {\n    \"file\": \"docker-compose.yml\",\n    \"line\": 4,\n    \"severity\": \"error\",\n    \"service\": \"web\",\n    \"message\": \"web: links to missing service database\"\n  }\n]\n",
+		},
+	)
+}
+
+func TestManifestRender(t *testing.T) {
+	dir := manifestDir(t, "web:\n  image: httpd\n")
+	defer os.RemoveAll(dir)
+
+	m, err := manifest.Read(dir, "docker-compose.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := m.Raw()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := testServer(t,
+		test.Http{Method: "POST", Path: "/apps/myapp/render", Body: url.Values{"manifest": {string(raw)}}.Encode(), Code: 200, Response: client.ManifestRender{
+			Template:   "{\n  \"Resources\": {\n    \"Web\": \"new\"\n  }\n}",
+			Parameters: map[string]string{"WebMemory": "256", "Release": "R1234"},
+			Current:    "{\n  \"Resources\": {\n    \"Web\": \"old\"\n  }\n}",
+		}},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox manifest render --app myapp",
+			Dir:     dir,
+			Exit:    0,
+			Stdout:  "# Template\n{\n  \"Resources\": {\n    \"Web\": \"new\"\n  }\n}\n\n# Parameters\nRelease=R1234\nWebMemory=256\n\n# Changes\n--- deployed\n+++ rendered\n@@ -1,5 +1,5 @@\n {\n   \"Resources\": {\n-    \"Web\": \"old\"\n+    \"Web\": \"new\"\n   }\n }\n",
+		},
+	)
+}
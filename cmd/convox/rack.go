@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -20,6 +21,20 @@ func init() {
 		Action:      cmdRack,
 		Flags:       []cli.Flag{rackFlag},
 		Subcommands: []cli.Command{
+			{
+				Name:        "graph",
+				Description: "show the links between apps and services",
+				Usage:       "[--format dot|json]",
+				Action:      cmdRackGraph,
+				Flags: []cli.Flag{
+					rackFlag,
+					cli.StringFlag{
+						Name:  "format",
+						Value: "dot",
+						Usage: "output format, dot or json",
+					},
+				},
+			},
 			{
 				Name:        "params",
 				Description: "list advanced rack parameters",
@@ -101,6 +116,59 @@ func cmdRack(c *cli.Context) error {
 	return nil
 }
 
+func cmdRackGraph(c *cli.Context) error {
+	if len(c.Args()) > 0 {
+		stdcli.Usage(c, "graph")
+		return nil
+	}
+
+	format := c.String("format")
+
+	if format != "dot" && format != "json" {
+		return stdcli.ExitError(fmt.Errorf("format must be dot or json"))
+	}
+
+	graph, err := rackClient(c).GetSystemGraph()
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return stdcli.ExitError(err)
+		}
+
+		fmt.Println(string(data))
+		return nil
+	}
+
+	// apps point at the services they depend on, labeled with the variable and the
+	// process of links scoped to one
+	fmt.Println("digraph rack {")
+
+	for _, a := range graph.Apps {
+		fmt.Printf("  \"app/%s\" [label=\"%s\", shape=box];\n", a, a)
+	}
+
+	for _, s := range graph.Services {
+		fmt.Printf("  \"service/%s\" [label=\"%s\\n%s\", shape=ellipse];\n", s.Name, s.Name, s.Type)
+	}
+
+	for _, l := range graph.Links {
+		label := l.Variable
+
+		if l.Process != "" {
+			label = fmt.Sprintf("%s (%s)", label, l.Process)
+		}
+
+		fmt.Printf("  \"app/%s\" -> \"service/%s\" [label=\"%s\"];\n", l.App, l.Service, label)
+	}
+
+	fmt.Println("}")
+	return nil
+}
+
 func cmdRackParams(c *cli.Context) error {
 	system, err := rackClient(c).GetSystem()
 	if err != nil {
@@ -57,3 +57,46 @@ func TestRackUpdateSpecified(t *testing.T) {
 		},
 	)
 }
+
+func TestRackGraph(t *testing.T) {
+	graph := client.SystemGraph{
+		Apps: []string{"api", "web"},
+		Services: []client.SystemGraphService{
+			{Name: "db", Type: "postgres"},
+			{Name: "logs", Type: "syslog"},
+		},
+		Links: client.Links{
+			{App: "api", Service: "db", Variable: "DB_URL"},
+			{App: "web", Service: "db", Process: "worker", Variable: "DB_URL"},
+			{App: "web", Service: "logs"},
+		},
+	}
+
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/system/graph", Code: 200, Response: graph},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox rack graph",
+			Exit:    0,
+			Stdout: `digraph rack {
+  "app/api" [label="api", shape=box];
+  "app/web" [label="web", shape=box];
+  "service/db" [label="db\npostgres", shape=ellipse];
+  "service/logs" [label="logs\nsyslog", shape=ellipse];
+  "app/api" -> "service/db" [label="DB_URL"];
+  "app/web" -> "service/db" [label="DB_URL (worker)"];
+  "app/web" -> "service/logs" [label=""];
+}
+`,
+		},
+		test.ExecRun{
+			Command: "convox rack graph --format svg",
+			Exit:    1,
+			Stderr:  "ERROR: format must be dot or json\n",
+		},
+	)
+}
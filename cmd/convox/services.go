@@ -5,6 +5,8 @@ import (
 	"math/rand"
 	"net"
 	"net/url"
+	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -12,50 +14,8 @@ import (
 	"gopkg.in/urfave/cli.v1"
 )
 
-type ServiceType struct {
-	name, args string
-}
-
 func init() {
-	types := []ServiceType{
-		ServiceType{
-			"mysql",
-			"[--allocated-storage=10] [--instance-type=db.t2.micro] [--multi-az] [--private]",
-		},
-		ServiceType{
-			"postgres",
-			"[--allocated-storage=10] [--instance-type=db.t2.micro] [--max-connections={DBInstanceClassMemory/15000000}] [--multi-az] [--private]",
-		},
-		ServiceType{
-			"redis",
-			"[--automatic-failover-enabled] [--instance-type=cache.t2.micro] [--num-cache-clusters=1] [--private]",
-		},
-		ServiceType{
-			"s3",
-			"[--topic=sns-service-name] [--versioning]",
-		},
-		ServiceType{
-			"sns",
-			"[--queue=sqs-service-name]",
-		},
-		ServiceType{
-			"sqs",
-			"",
-		},
-		ServiceType{
-			"syslog",
-			"--url=tcp+tls://logs1.papertrailapp.com:11235",
-		},
-		ServiceType{
-			"webhook",
-			"--url=https://console.convox.com/webhooks/1234",
-		},
-	}
-
-	usage := "Supported types / options:"
-	for _, t := range types {
-		usage += fmt.Sprintf("\n  %-10s  %s", t.name, t.args)
-	}
+	usage := "Run `convox services types` to list the supported types and their options."
 
 	stdcli.RegisterCommand(cli.Command{
 		Name:        "services",
@@ -77,16 +37,96 @@ func init() {
 				Description: "delete a service",
 				Usage:       "<name>",
 				Action:      cmdServiceDelete,
-				Flags:       []cli.Flag{rackFlag},
+				Flags: []cli.Flag{
+					rackFlag,
+					cli.BoolFlag{
+						Name:  "force",
+						Usage: "unlink apps and delete, or delete a database without a final snapshot",
+					},
+				},
 			},
 			{
 				Name:            "update",
 				Description:     "update a service.\n\nWARNING: updates may cause service downtime.",
-				Usage:           "<name> --option-name=value [--option-name=value]\n\n" + usage,
+				Usage:           "<name> --option-name=value [--option-name=value] [--dry-run] [--confirm]\n\n" + usage + "\n\n--dry-run lists the resources the update would modify or replace. Updates that replace a database or other stateful resource need --confirm.",
 				Action:          cmdServiceUpdate,
 				Flags:           []cli.Flag{rackFlag},
 				SkipFlagParsing: true,
 			},
+			{
+				Name:        "types",
+				Description: "list the service types and their options",
+				Usage:       "[type]",
+				Action:      cmdServiceTypes,
+				Flags:       []cli.Flag{rackFlag},
+			},
+			{
+				Name:        "backup",
+				Description: "take a snapshot of the data of a database service",
+				Usage:       "<name>",
+				Action:      cmdServiceBackup,
+				Flags:       []cli.Flag{rackFlag},
+			},
+			{
+				Name:        "backups",
+				Description: "list the snapshots of a database service",
+				Usage:       "<name>",
+				Action:      cmdServiceBackups,
+				Flags:       []cli.Flag{rackFlag},
+			},
+			{
+				Name:        "restore",
+				Description: "replace the data of a database service with a snapshot.\n\nLinked apps are given the new URL of the service and promoted once the restore finishes.\n\nWARNING: the current data is lost unless backed up first.",
+				Usage:       "<name> --from=<backup>",
+				Action:      cmdServiceRestore,
+				Flags: []cli.Flag{
+					rackFlag,
+					cli.StringFlag{
+						Name:  "from",
+						Usage: "id of the backup to restore",
+					},
+				},
+			},
+			{
+				Name:        "clone",
+				Description: "create a database service from a snapshot of another",
+				Usage:       "<name> <new-name>",
+				Action:      cmdServiceClone,
+				Flags: []cli.Flag{
+					rackFlag,
+					cli.StringFlag{
+						Name:  "from",
+						Usage: "id of the backup to clone, defaults to the latest",
+					},
+				},
+			},
+			{
+				Name:        "export",
+				Description: "write a dump of the database of a service to stdout",
+				Usage:       "<name> > dump.sql",
+				Action:      cmdServiceExport,
+				Flags:       []cli.Flag{rackFlag},
+			},
+			{
+				Name:        "import",
+				Description: "load a dump from stdin into the database of a service",
+				Usage:       "<name> < dump.sql",
+				Action:      cmdServiceImport,
+				Flags:       []cli.Flag{rackFlag},
+			},
+			{
+				Name:        "rotate-credentials",
+				Description: "replace the password of a service and update the apps linked to it",
+				Usage:       "<name> [--promote]",
+				Action:      cmdServiceRotateCredentials,
+				Flags: []cli.Flag{
+					rackFlag,
+					cli.BoolFlag{
+						Name:  "promote",
+						Usage: "promote the new releases of linked apps",
+					},
+				},
+			},
 			{
 				Name:        "info",
 				Description: "info about a service.",
@@ -97,8 +137,26 @@ func init() {
 			{
 				Name:        "link",
 				Description: "create a link between a service and an app.",
-				Usage:       "<name>",
+				Usage:       "<name> [name...]\n\nServices set <NAME>_URL in the app environment, choose another prefix for a single service with --as.",
 				Action:      cmdLinkCreate,
+				Flags: []cli.Flag{
+					appFlag,
+					rackFlag,
+					cli.StringFlag{
+						Name:  "as",
+						Usage: "prefix of the environment variable, e.g. PRIMARY_DB sets PRIMARY_DB_URL",
+					},
+					cli.StringFlag{
+						Name:  "process",
+						Usage: "only link the service to this process of the app",
+					},
+				},
+			},
+			{
+				Name:        "links",
+				Description: "list the services linked to an app.",
+				Usage:       "",
+				Action:      cmdLinkList,
 				Flags:       []cli.Flag{appFlag, rackFlag},
 			},
 			{
@@ -106,7 +164,14 @@ func init() {
 				Description: "delete a link between a service and an app.",
 				Usage:       "<name>",
 				Action:      cmdLinkDelete,
-				Flags:       []cli.Flag{appFlag, rackFlag},
+				Flags: []cli.Flag{
+					appFlag,
+					rackFlag,
+					cli.StringFlag{
+						Name:  "process",
+						Usage: "process the service was linked to",
+					},
+				},
 			},
 			{
 				Name:        "proxy",
@@ -141,10 +206,20 @@ func cmdServices(c *cli.Context) error {
 		return stdcli.ExitError(err)
 	}
 
-	t := stdcli.NewTable("NAME", "TYPE", "STATUS")
+	t := stdcli.NewTable("NAME", "TYPE", "STATUS", "LINKS")
 
 	for _, service := range services {
-		t.AddRow(service.Name, service.Type, service.Status)
+		links := []string{}
+
+		for _, l := range service.Links {
+			if l.Process != "" {
+				links = append(links, fmt.Sprintf("%s/%s", l.App, l.Process))
+			} else {
+				links = append(links, l.App)
+			}
+		}
+
+		t.AddRow(service.Name, service.Type, service.Status, strings.Join(links, ", "))
 	}
 
 	t.Print()
@@ -178,8 +253,13 @@ func cmdServiceCreate(c *cli.Context) error {
 		}
 	}
 
+	secrets := secretOptions(c, t)
+
 	var optionsList []string
 	for key, val := range options {
+		if secrets[key] {
+			val = "****"
+		}
 		optionsList = append(optionsList, fmt.Sprintf("%s=%q", key, val))
 	}
 
@@ -202,6 +282,84 @@ func cmdServiceCreate(c *cli.Context) error {
 	return nil
 }
 
+func cmdServiceTypes(c *cli.Context) error {
+	if len(c.Args()) > 1 {
+		stdcli.Usage(c, "types")
+		return nil
+	}
+
+	types, err := rackClient(c).GetServiceTypes()
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if len(c.Args()) == 0 {
+		t := stdcli.NewTable("TYPE", "OPTIONS")
+
+		for _, st := range types {
+			options := []string{}
+
+			for _, p := range st.Parameters {
+				switch {
+				case p.Required:
+					options = append(options, fmt.Sprintf("--%s=<%s>", p.Name, p.Type))
+				case p.Type == "boolean":
+					options = append(options, fmt.Sprintf("[--%s]", p.Name))
+				default:
+					options = append(options, fmt.Sprintf("[--%s=%s]", p.Name, p.Default))
+				}
+			}
+
+			t.AddRow(st.Name, strings.Join(options, " "))
+		}
+
+		t.Print()
+		return nil
+	}
+
+	name := c.Args()[0]
+
+	for _, st := range types {
+		if st.Name != name {
+			continue
+		}
+
+		fmt.Printf("%s: %s\n\n", st.Name, st.Description)
+
+		if len(st.Parameters) == 0 {
+			fmt.Println("no options")
+			return nil
+		}
+
+		t := stdcli.NewTable("OPTION", "TYPE", "DEFAULT", "DESCRIPTION")
+
+		for _, p := range st.Parameters {
+			kind := p.Type
+
+			if len(p.Allowed) > 0 {
+				kind = strings.Join(p.Allowed, "|")
+			}
+
+			description := p.Description
+
+			if p.Required {
+				description += " (required)"
+			}
+
+			if p.Secret {
+				description += " (secret)"
+			}
+
+			t.AddRow("--"+p.Name, kind, p.Default, description)
+		}
+
+		t.Print()
+		return nil
+	}
+
+	return stdcli.ExitError(fmt.Errorf("no such service type: %s", name))
+}
+
 func cmdServiceUpdate(c *cli.Context) error {
 	// ensure name included
 	if !(len(c.Args()) > 0) {
@@ -224,8 +382,23 @@ func cmdServiceUpdate(c *cli.Context) error {
 		}
 	}
 
+	_, dryRun := options["dry-run"]
+	delete(options, "dry-run")
+
+	var secrets map[string]bool
+
+	if service, err := rackClient(c).GetService(name); err == nil {
+		secrets = secretOptions(c, service.Type)
+	}
+
 	var optionsList []string
 	for key, val := range options {
+		if key == "confirm" {
+			continue
+		}
+		if secrets[key] {
+			val = "****"
+		}
 		optionsList = append(optionsList, fmt.Sprintf("%s=%q", key, val))
 	}
 
@@ -234,6 +407,10 @@ func cmdServiceUpdate(c *cli.Context) error {
 		return nil
 	}
 
+	if dryRun {
+		return previewServiceUpdate(c, name, options)
+	}
+
 	fmt.Printf("Updating %s (%s)...", name, strings.Join(optionsList, " "))
 
 	_, err := rackClient(c).UpdateService(name, options)
@@ -245,6 +422,70 @@ func cmdServiceUpdate(c *cli.Context) error {
 	return nil
 }
 
+func previewServiceUpdate(c *cli.Context, name string, options map[string]string) error {
+	delete(options, "confirm")
+
+	changes, err := rackClient(c).PreviewServiceUpdate(name, options)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if len(changes) == 0 {
+		fmt.Printf("No changes to %s\n", name)
+		return nil
+	}
+
+	t := stdcli.NewTable("ACTION", "RESOURCE", "TYPE", "REPLACEMENT")
+
+	replacing := []string{}
+
+	for _, ch := range changes {
+		replacement := ""
+
+		if ch.Action == "Modify" {
+			replacement = strings.ToLower(ch.Replacement)
+		}
+
+		t.AddRow(ch.Action, ch.Resource, ch.Type, replacement)
+
+		if ch.Stateful && (ch.Action == "Remove" || ch.Replacement == "True" || ch.Replacement == "Conditional") {
+			replacing = append(replacing, ch.Resource)
+		}
+	}
+
+	t.Print()
+
+	if len(replacing) > 0 {
+		fmt.Printf("\nWARNING: %s may be replaced and lose its data, update with --confirm to continue\n", strings.Join(replacing, ", "))
+	}
+
+	return nil
+}
+
+// secretOptions returns the options of a service type whose values should not be shown
+func secretOptions(c *cli.Context, kind string) map[string]bool {
+	secrets := map[string]bool{}
+
+	types, err := rackClient(c).GetServiceTypes()
+	if err != nil {
+		return secrets
+	}
+
+	for _, t := range types {
+		if t.Name != kind {
+			continue
+		}
+
+		for _, p := range t.Parameters {
+			if p.Secret {
+				secrets[p.Name] = true
+			}
+		}
+	}
+
+	return secrets
+}
+
 func cmdServiceDelete(c *cli.Context) error {
 	if len(c.Args()) != 1 {
 		stdcli.Usage(c, "delete")
@@ -255,7 +496,7 @@ func cmdServiceDelete(c *cli.Context) error {
 
 	fmt.Printf("Deleting %s... ", name)
 
-	_, err := rackClient(c).DeleteService(name)
+	_, err := rackClient(c).DeleteService(name, c.Bool("force"))
 	if err != nil {
 		return stdcli.ExitError(err)
 	}
@@ -264,6 +505,166 @@ func cmdServiceDelete(c *cli.Context) error {
 	return nil
 }
 
+func cmdServiceBackup(c *cli.Context) error {
+	if len(c.Args()) != 1 {
+		stdcli.Usage(c, "backup")
+		return nil
+	}
+
+	name := c.Args()[0]
+
+	fmt.Printf("Backing up %s... ", name)
+
+	backup, err := rackClient(c).CreateServiceBackup(name)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Printf("OK, %s\n", backup.Id)
+	return nil
+}
+
+func cmdServiceBackups(c *cli.Context) error {
+	if len(c.Args()) != 1 {
+		stdcli.Usage(c, "backups")
+		return nil
+	}
+
+	backups, err := rackClient(c).GetServiceBackups(c.Args()[0])
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	t := stdcli.NewTable("ID", "STATUS", "SIZE", "CREATED")
+
+	for _, b := range backups {
+		t.AddRow(b.Id, b.Status, fmt.Sprintf("%dGB", b.Size), humanizeTime(b.Created))
+	}
+
+	t.Print()
+	return nil
+}
+
+func cmdServiceRestore(c *cli.Context) error {
+	if len(c.Args()) != 1 || c.String("from") == "" {
+		stdcli.Usage(c, "restore")
+		return nil
+	}
+
+	name := c.Args()[0]
+	from := c.String("from")
+
+	fmt.Printf("Restoring %s from %s... ", name, from)
+
+	_, err := rackClient(c).RestoreServiceBackup(name, from)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Println("UPDATING")
+	return nil
+}
+
+func cmdServiceClone(c *cli.Context) error {
+	if len(c.Args()) != 2 {
+		stdcli.Usage(c, "clone")
+		return nil
+	}
+
+	name := c.Args()[0]
+	clone := c.Args()[1]
+	from := c.String("from")
+
+	if from == "" {
+		backups, err := rackClient(c).GetServiceBackups(name)
+		if err != nil {
+			return stdcli.ExitError(err)
+		}
+
+		// backups are listed newest first
+		for _, b := range backups {
+			if b.Status == "available" {
+				from = b.Id
+				break
+			}
+		}
+
+		if from == "" {
+			return stdcli.ExitError(fmt.Errorf("%s has no available backups, run `convox services backup %s` first", name, name))
+		}
+	}
+
+	fmt.Printf("Cloning %s to %s from %s... ", name, clone, from)
+
+	_, err := rackClient(c).CloneServiceBackup(name, from, clone)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Println("CREATING")
+	return nil
+}
+
+func cmdServiceExport(c *cli.Context) error {
+	if len(c.Args()) != 1 {
+		stdcli.Usage(c, "export")
+		return nil
+	}
+
+	if err := rackClient(c).ExportService(c.Args()[0], os.Stdout); err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	return nil
+}
+
+func cmdServiceImport(c *cli.Context) error {
+	if len(c.Args()) != 1 {
+		stdcli.Usage(c, "import")
+		return nil
+	}
+
+	name := c.Args()[0]
+
+	stat, err := os.Stdin.Stat()
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if (stat.Mode() & os.ModeCharDevice) != 0 {
+		return stdcli.ExitError(fmt.Errorf("pipe a dump to import, e.g. `convox services import %s < dump.sql`", name))
+	}
+
+	fmt.Fprintf(os.Stderr, "Importing into %s... ", name)
+
+	if err := rackClient(c).ImportService(name, os.Stdin, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr)
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Fprintln(os.Stderr, "OK")
+	return nil
+}
+
+func cmdServiceRotateCredentials(c *cli.Context) error {
+	if len(c.Args()) != 1 {
+		stdcli.Usage(c, "rotate-credentials")
+		return nil
+	}
+
+	name := c.Args()[0]
+
+	fmt.Printf("Rotating credentials for %s... ", name)
+
+	_, err := rackClient(c).RotateServiceCredentials(name, c.Bool("promote"))
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	fmt.Println("UPDATING")
+	return nil
+}
+
 func cmdServiceInfo(c *cli.Context) error {
 	if len(c.Args()) != 1 {
 		stdcli.Usage(c, "info")
@@ -284,6 +685,30 @@ func cmdServiceInfo(c *cli.Context) error {
 		fmt.Printf("Reason  %s\n", service.StatusReason)
 	}
 
+	if h := service.Health; h != nil {
+		if h.Reason != "" {
+			fmt.Printf("Health  %s (%s), checked %s\n", h.Status, h.Reason, humanizeTime(h.Checked))
+		} else {
+			fmt.Printf("Health  %s, checked %s\n", h.Status, humanizeTime(h.Checked))
+		}
+
+		if len(h.Metrics) > 0 {
+			fmt.Printf("Metrics\n")
+
+			keys := []string{}
+
+			for key := range h.Metrics {
+				keys = append(keys, key)
+			}
+
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				fmt.Printf("  %s: %s\n", key, strconv.FormatFloat(h.Metrics[key], 'f', -1, 64))
+			}
+		}
+	}
+
 	if len(service.Exports) > 0 {
 		fmt.Printf("Exports\n")
 
@@ -304,19 +729,62 @@ func cmdLinkCreate(c *cli.Context) error {
 		return stdcli.ExitError(err)
 	}
 
-	if len(c.Args()) != 1 {
+	if len(c.Args()) < 1 {
 		stdcli.Usage(c, "link")
 		return nil
 	}
 
-	name := c.Args()[0]
+	if len(c.Args()) > 1 && c.String("as") != "" {
+		return stdcli.ExitError(fmt.Errorf("--as can only be used when linking a single service"))
+	}
+
+	release := latestRelease(c, app)
+
+	for _, name := range c.Args() {
+		_, err = rackClient(c).CreateLink(app, name, c.String("process"), c.String("as"))
+		if err != nil {
+			return stdcli.ExitError(err)
+		}
+
+		fmt.Printf("Linked %s to %s\n", name, app)
+	}
+
+	if r := latestRelease(c, app); r != release {
+		fmt.Printf("To deploy these changes run `convox releases promote %s`\n", r)
+	}
+
+	return nil
+}
+
+func cmdLinkList(c *cli.Context) error {
+	_, app, err := stdcli.DirApp(c, ".")
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if len(c.Args()) > 0 {
+		stdcli.Usage(c, "links")
+		return nil
+	}
 
-	_, err = rackClient(c).CreateLink(app, name)
+	links, err := rackClient(c).GetLinks(app)
 	if err != nil {
 		return stdcli.ExitError(err)
 	}
 
-	fmt.Printf("Linked %s to %s\n", name, app)
+	t := stdcli.NewTable("SERVICE", "VARIABLE", "PROCESS")
+
+	for _, l := range links {
+		process := l.Process
+
+		if process == "" {
+			process = "*"
+		}
+
+		t.AddRow(l.Service, l.Variable, process)
+	}
+
+	t.Print()
 	return nil
 }
 
@@ -332,16 +800,33 @@ func cmdLinkDelete(c *cli.Context) error {
 	}
 
 	name := c.Args()[0]
+	release := latestRelease(c, app)
 
-	_, err = rackClient(c).DeleteLink(app, name)
+	_, err = rackClient(c).DeleteLink(app, name, c.String("process"))
 	if err != nil {
 		return stdcli.ExitError(err)
 	}
 
 	fmt.Printf("Unlinked %s from %s\n", name, app)
+
+	if r := latestRelease(c, app); r != release {
+		fmt.Printf("To deploy these changes run `convox releases promote %s`\n", r)
+	}
+
 	return nil
 }
 
+// latestRelease returns the id of the latest release of an app, links through the
+// environment create a release while links that subscribe services to the app do not
+func latestRelease(c *cli.Context, app string) string {
+	releases, err := rackClient(c).GetReleases(app)
+	if err != nil || len(releases) == 0 {
+		return ""
+	}
+
+	return releases[0].Id
+}
+
 func cmdServiceProxy(c *cli.Context) error {
 	if len(c.Args()) != 1 {
 		stdcli.Usage(c, "info")
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/convox/rack/client"
+	"github.com/convox/rack/test"
+)
+
+func TestServicesTypes(t *testing.T) {
+	types := client.ServiceTypes{
+		client.ServiceType{
+			Name:        "redis",
+			Description: "ElastiCache Redis replication group",
+			Parameters: []client.ServiceParameter{
+				{Name: "instance-type", Type: "string", Default: "cache.t2.micro", Description: "The type of instance to use"},
+				{Name: "private", Type: "boolean", Default: "false", Allowed: []string{"true", "false"}, Description: "Create in private subnets"},
+			},
+		},
+		client.ServiceType{
+			Name:        "syslog",
+			Description: "Forward app logs to a syslog server",
+			Parameters: []client.ServiceParameter{
+				{Name: "url", Type: "url", Description: "Syslog server", Required: true},
+			},
+		},
+	}
+
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/services/types", Code: 200, Response: types},
+		test.Http{Method: "GET", Path: "/services/types", Code: 200, Response: types},
+		test.Http{Method: "GET", Path: "/services/types", Code: 200, Response: types},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services types",
+			Exit:    0,
+			Stdout:  "TYPE    OPTIONS                                     \nredis   [--instance-type=cache.t2.micro] [--private]\nsyslog  --url=<url>                                 \n",
+		},
+		test.ExecRun{
+			Command: "convox services types redis",
+			Exit:    0,
+			Stdout:  "redis: ElastiCache Redis replication group\n\nOPTION           TYPE        DEFAULT         DESCRIPTION                \n--instance-type  string      cache.t2.micro  The type of instance to use\n--private        true|false  false           Create in private subnets  \n",
+		},
+		test.ExecRun{
+			Command: "convox services types mongo",
+			Exit:    1,
+			Stderr:  "ERROR: no such service type: mongo\n",
+		},
+	)
+}
+
+func TestServicesBackups(t *testing.T) {
+	backups := client.ServiceBackups{
+		client.ServiceBackup{Id: "convox-db-20170102030405", Service: "db", Status: "available", Size: 10},
+		client.ServiceBackup{Id: "convox-db-20170101030405", Service: "db", Status: "available", Size: 10},
+	}
+
+	ts := testServer(t,
+		test.Http{Method: "POST", Path: "/services/db/backups", Code: 200, Response: backups[0]},
+		test.Http{Method: "GET", Path: "/services/db/backups", Code: 200, Response: backups},
+		test.Http{Method: "POST", Path: "/services/db/backups/convox-db-20170101030405/restore", Code: 200, Response: client.Service{Name: "db"}},
+		test.Http{Method: "GET", Path: "/services/db/backups", Code: 200, Response: backups},
+		test.Http{Method: "POST", Path: "/services/db/backups/convox-db-20170102030405/clone", Body: "name=db2", Code: 200, Response: client.Service{Name: "db2"}},
+		test.Http{Method: "DELETE", Path: "/services/db", Code: 200, Response: client.Service{Name: "db"}},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services backup db",
+			Exit:    0,
+			Stdout:  "Backing up db... OK, convox-db-20170102030405\n",
+		},
+		test.ExecRun{
+			Command: "convox services backups db",
+			Exit:    0,
+			Stdout:  "ID                        STATUS     SIZE  CREATED\nconvox-db-20170102030405  available  10GB         \nconvox-db-20170101030405  available  10GB         \n",
+		},
+		test.ExecRun{
+			Command: "convox services restore db --from convox-db-20170101030405",
+			Exit:    0,
+			Stdout:  "Restoring db from convox-db-20170101030405... UPDATING\n",
+		},
+		test.ExecRun{
+			Command: "convox services clone db db2",
+			Exit:    0,
+			Stdout:  "Cloning db to db2 from convox-db-20170102030405... CREATING\n",
+		},
+		test.ExecRun{
+			Command: "convox services delete db --force",
+			Exit:    0,
+			Stdout:  "Deleting db... DELETING\n",
+		},
+	)
+}
+
+func TestServicesImportWithoutInput(t *testing.T) {
+	ts := testServer(t)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services import db",
+			Exit:    1,
+			Stderr:  "ERROR: pipe a dump to import, e.g. `convox services import db < dump.sql`\n",
+		},
+	)
+}
+
+func TestServicesUpdate(t *testing.T) {
+	types := client.ServiceTypes{
+		client.ServiceType{
+			Name: "postgres",
+			Parameters: []client.ServiceParameter{
+				{Name: "instance-type", Type: "string"},
+				{Name: "password", Type: "string", Secret: true},
+			},
+		},
+	}
+
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/services/db", Code: 200, Response: client.Service{Name: "db", Type: "postgres"}},
+		test.Http{Method: "GET", Path: "/services/types", Code: 200, Response: types},
+		test.Http{Method: "PUT", Path: "/services/db", Body: "password=hunter2", Code: 200, Response: client.Service{Name: "db", Type: "postgres"}},
+	)
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services update db --password=hunter2",
+			Exit:    0,
+			Stdout:  "Updating db (password=\"****\")...UPDATING\n",
+		},
+	)
+
+	ts.Close()
+
+	changes := client.ServiceChanges{
+		{Action: "Modify", Resource: "Instance", Type: "AWS::RDS::DBInstance", Replacement: "True", Stateful: true},
+		{Action: "Modify", Resource: "SecurityGroup", Type: "AWS::EC2::SecurityGroup", Replacement: "False"},
+	}
+
+	ts = testServer(t,
+		test.Http{Method: "GET", Path: "/services/db", Code: 200, Response: client.Service{Name: "db", Type: "postgres"}},
+		test.Http{Method: "GET", Path: "/services/types", Code: 200, Response: types},
+		test.Http{Method: "PUT", Path: "/services/db", Body: "dry-run=true&instance-type=db.m4.large", Code: 200, Response: changes},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services update db --instance-type=db.m4.large --dry-run",
+			Exit:    0,
+			Stdout:  "ACTION  RESOURCE       TYPE                     REPLACEMENT\nModify  Instance       AWS::RDS::DBInstance     true       \nModify  SecurityGroup  AWS::EC2::SecurityGroup  false      \n\nWARNING: Instance may be replaced and lose its data, update with --confirm to continue\n",
+		},
+	)
+}
+
+func TestServicesLink(t *testing.T) {
+	links := client.Links{
+		{App: "myapp", Service: "primary", Process: "web", Variable: "PRIMARY_DB_URL"},
+		{App: "myapp", Service: "cache", Variable: "CACHE_URL"},
+	}
+
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/apps/myapp/releases", Code: 200, Response: client.Releases{{Id: "R1234"}}},
+		test.Http{Method: "POST", Path: "/services/primary/links", Body: "app=myapp&as=PRIMARY_DB&process=web", Code: 200, Response: client.Service{Name: "primary"}},
+		test.Http{Method: "GET", Path: "/apps/myapp/links", Code: 200, Response: links},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services link primary --app myapp --as PRIMARY_DB --process web",
+			Exit:    0,
+			Stdout:  "Linked primary to myapp\n",
+		},
+		test.ExecRun{
+			Command: "convox services link primary cache --app myapp --as PRIMARY_DB",
+			Exit:    1,
+			Stderr:  "ERROR: --as can only be used when linking a single service\n",
+		},
+		test.ExecRun{
+			Command: "convox services links --app myapp",
+			Exit:    0,
+			Stdout:  "SERVICE  VARIABLE        PROCESS\nprimary  PRIMARY_DB_URL  web    \ncache    CACHE_URL       *      \n",
+		},
+	)
+}
+
+func TestServicesRotateCredentials(t *testing.T) {
+	ts := testServer(t,
+		test.Http{Method: "POST", Path: "/services/db/rotate-credentials", Body: "promote=true", Code: 200, Response: client.Service{Name: "db", Status: "updating"}},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services rotate-credentials db --promote",
+			Exit:    0,
+			Stdout:  "Rotating credentials for db... UPDATING\n",
+		},
+	)
+}
+
+func TestServicesInfoHealth(t *testing.T) {
+	service := client.Service{
+		Name:   "db",
+		Status: "running",
+		Health: &client.ServiceHealth{
+			Status:  "unhealthy",
+			Reason:  "0.5GB of 10GB storage free",
+			Checked: time.Now(),
+			Metrics: map[string]float64{"storage-free": 0.5, "connections": 4},
+		},
+	}
+
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/services/db", Code: 200, Response: service},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services info db",
+			Exit:    0,
+			Stdout:  "Name    db\nStatus  running\nHealth  unhealthy (0.5GB of 10GB storage free), checked now\nMetrics\n  connections: 4\n  storage-free: 0.5\n",
+		},
+	)
+}
+
+func TestServicesListLinks(t *testing.T) {
+	services := client.Services{
+		{
+			Name:   "db",
+			Type:   "external",
+			Status: "running",
+			Links: client.Links{
+				{App: "api", Service: "db", Variable: "DB_URL"},
+				{App: "web", Service: "db", Process: "worker", Variable: "DB_URL"},
+			},
+		},
+		{Name: "logs", Type: "syslog", Status: "running"},
+	}
+
+	ts := testServer(t,
+		test.Http{Method: "GET", Path: "/services", Code: 200, Response: services},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services",
+			Exit:    0,
+			Stdout:  "NAME  TYPE      STATUS   LINKS          \ndb    external  running  api, web/worker\nlogs  syslog    running                 \n",
+		},
+	)
+}
+
+func TestServicesDeleteLinked(t *testing.T) {
+	ts := testServer(t,
+		test.Http{Method: "DELETE", Path: "/services/db", Code: 403, Response: client.Error{Error: "db is linked to api, web. Unlink it first or delete with --force"}},
+	)
+
+	defer ts.Close()
+
+	test.Runs(t,
+		test.ExecRun{
+			Command: "convox services delete db",
+			Exit:    1,
+			Stdout:  "Deleting db... ",
+			Stderr:  "ERROR: db is linked to api, web. Unlink it first or delete with --force\n",
+		},
+	)
+}
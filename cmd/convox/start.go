@@ -3,7 +3,9 @@ package main
 import (
 	"errors"
 	"fmt"
-	"os/exec"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,7 +20,7 @@ func init() {
 	stdcli.RegisterCommand(cli.Command{
 		Name:        "start",
 		Description: "start an app for local development",
-		Usage:       "[directory]",
+		Usage:       "[directory] [process...]",
 		Action:      cmdStart,
 		Flags: []cli.Flag{
 			cli.StringFlag{
@@ -26,18 +28,26 @@ func init() {
 				Value: "docker-compose.yml",
 				Usage: "path to an alternate docker compose manifest file",
 			},
+			cli.StringFlag{
+				Name:  "exclude",
+				Usage: "comma separated processes not to start",
+			},
 			cli.BoolFlag{
 				Name:  "no-cache",
 				Usage: "pull fresh image dependencies",
 			},
-			cli.IntFlag{
+			cli.StringFlag{
 				Name:  "shift",
-				Usage: "Shift allocated port numbers by the given amount",
+				Usage: "Shift allocated port numbers by the given amount, or auto to pick a shift that leaves them free",
 			},
 			cli.BoolTFlag{
 				Name:  "sync",
 				Usage: "synchronize local file changes into the running containers",
 			},
+			cli.BoolFlag{
+				Name:  "watch",
+				Usage: "rebuild and restart a process when its Dockerfile, lockfiles or convox.start.rebuild paths change",
+			},
 		},
 	})
 }
@@ -50,17 +60,15 @@ func cmdStart(c *cli.Context) error {
 		return stdcli.QOSEventSend("cli-start", distinctId, stdcli.QOSEventProperties{Error: err})
 	}
 
-	dockerTest := exec.Command("docker", "images")
-	err = dockerTest.Run()
-	if err != nil {
-		return stdcli.ExitError(errors.New("could not connect to docker daemon, is it installed and running?"))
-	}
-
 	dockerVersionTest, err := docker.NewClientFromEnv()
 	if err != nil {
 		return stdcli.ExitError(err)
 	}
 
+	if err := dockerVersionTest.Ping(); err != nil {
+		return stdcli.ExitError(errors.New("could not connect to docker daemon, is it installed and running?"))
+	}
+
 	minDockerVersion, err := docker.NewAPIVersion("1.9")
 	e, err := dockerVersionTest.Version()
 	if err != nil {
@@ -79,16 +87,14 @@ func cmdStart(c *cli.Context) error {
 
 	cache := !c.Bool("no-cache")
 
-	shift := 0
-
-	if si := c.Int("shift"); si > 0 {
-		shift = si
-	}
-
 	wd := "."
+	args := c.Args()
 
-	if len(c.Args()) > 0 {
-		wd = c.Args()[0]
+	// a leading directory argument selects the app, the rest are processes. a directory
+	// named like a process is only taken as the app when it holds its own manifest.
+	if len(args) > 0 && isAppDir(args[0], c.String("file")) {
+		wd = args[0]
+		args = args[1:]
 	}
 
 	dir, app, err := stdcli.DirApp(c, wd)
@@ -98,7 +104,7 @@ func cmdStart(c *cli.Context) error {
 
 	file := c.String("file")
 
-	m, err := manifest.Read(dir, file)
+	m, err := manifest.ReadInterpolated(dir, file)
 	if err != nil {
 		switch err.(type) {
 		case *manifest.YAMLError:
@@ -111,20 +117,72 @@ func cmdStart(c *cli.Context) error {
 				return stdcli.QOSEventSend("cli-start", distinctId, stdcli.QOSEventProperties{Error: err})
 			}
 
-			m, err = manifest.Read(dir, file)
+			m, err = manifest.ReadInterpolated(dir, file)
 			if err != nil {
 				return stdcli.QOSEventSend("cli-start", distinctId, stdcli.QOSEventProperties{Error: err})
 			}
 		}
 	}
 
-	conflicts, err := m.PortConflicts(shift)
+	if err := m.AddLocalServices(); err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	exclude := []string{}
+
+	if e := c.String("exclude"); e != "" {
+		exclude = strings.Split(e, ",")
+	}
+
+	m, err = m.Subset(args, exclude)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	// the shift and proxy ports of the last start are kept unless a shift is given
+	ports, err := manifest.LoadPortState(dir, app)
+	if err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	switch s := c.String("shift"); s {
+	case "":
+	case "auto":
+		shift, err := m.AutoShift(ports.Shift)
+		if err != nil {
+			return stdcli.ExitError(err)
+		}
+
+		ports.Shift = shift
+	default:
+		shift, err := strconv.Atoi(s)
+		if err != nil || shift < 0 {
+			return stdcli.ExitError(fmt.Errorf("shift must be a positive number or auto"))
+		}
+
+		ports.Shift = shift
+	}
+
+	conflicts, err := m.PortConflicts(ports.Shift)
 	if err != nil {
 		return stdcli.QOSEventSend("cli-start", distinctId, stdcli.QOSEventProperties{Error: err})
 	}
 
 	if len(conflicts) > 0 {
-		return stdcli.ExitError(fmt.Errorf("ports in use: %s", strings.Join(conflicts, ", ")))
+		return stdcli.ExitError(fmt.Errorf("ports in use: %s, use --shift auto to pick free ports", strings.Join(conflicts, ", ")))
+	}
+
+	if err := ports.Allocate(m); err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	if err := ports.Save(); err != nil {
+		return stdcli.ExitError(err)
+	}
+
+	urls, err := m.HostURLs(ports)
+	if err != nil {
+		return stdcli.ExitError(err)
 	}
 
 	missing, err := m.MissingEnvironment(cache, app)
@@ -136,20 +194,55 @@ func cmdStart(c *cli.Context) error {
 		return stdcli.ExitError(fmt.Errorf("env expected: %s", strings.Join(missing, ", ")))
 	}
 
-	errors := m.Build(app, dir, cache)
+	errors := m.Build(app, dir, manifest.BuildOptions{
+		Cache:       cache,
+		Environment: localEnvironment(),
+	})
 	if len(errors) != 0 {
 		return stdcli.QOSEventSend("cli-start", distinctId, stdcli.QOSEventProperties{Error: errors[0]})
 	}
 
 	sync := c.Bool("sync") && (stdcli.ReadSetting("sync") != "false")
 
+	if len(urls) > 0 {
+		t := stdcli.NewTable("SERVICE", "PORT", "URL")
+
+		for _, u := range urls {
+			t.AddRow(u.Process, u.Container, u.URL)
+		}
+
+		t.Print()
+	}
+
 	ch := make(chan []error)
 
 	go func() {
-		ch <- m.Run(app, cache, sync, shift)
+		ch <- m.Run(app, manifest.RunOptions{
+			Cache:       cache,
+			Dir:         dir,
+			Environment: localEnvironment(),
+			Ports:       ports,
+			Shift:       ports.Shift,
+			Sync:        sync,
+			Watch:       c.Bool("watch"),
+		})
 	}()
 
 	<-ch
 
 	return stdcli.QOSEventSend("cli-start", distinctId, ep)
 }
+
+func isAppDir(dir, file string) bool {
+	if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+		return false
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, file)); err == nil {
+		return true
+	}
+
+	_, err := os.Stat(file)
+
+	return err != nil
+}
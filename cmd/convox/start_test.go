@@ -75,7 +75,7 @@ func TestStartWithNoEnvOk(t *testing.T) {
 			Command:  fmt.Sprintf("convox start"),
 			Dir:      appDir,
 			Env:      map[string]string{"CONVOX_CONFIG": temp},
-			OutMatch: "docker run",
+			OutMatch: "running app/www as app-www",
 			Exit:     0,
 		},
 	)
@@ -95,7 +95,7 @@ func TestStartWithMapEnv(t *testing.T) {
 			Command:  fmt.Sprintf("convox start"),
 			Dir:      appDir,
 			Env:      map[string]string{"CONVOX_CONFIG": temp},
-			OutMatch: "docker run",
+			OutMatch: "running app/www as app-www",
 			Exit:     0,
 		},
 	)
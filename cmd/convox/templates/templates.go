@@ -1,5 +1,23 @@
 // Code generated by go-bindata.
 // sources:
+// templates/init/go/.dockerignore
+// templates/init/go/Dockerfile
+// templates/init/go/docker-compose.yml
+// templates/init/gradle/.dockerignore
+// templates/init/gradle/Dockerfile
+// templates/init/gradle/docker-compose.yml
+// templates/init/maven/.dockerignore
+// templates/init/maven/Dockerfile
+// templates/init/maven/docker-compose.yml
+// templates/init/meteor/.dockerignore
+// templates/init/meteor/Dockerfile
+// templates/init/meteor/docker-compose.yml
+// templates/init/node/.dockerignore
+// templates/init/node/Dockerfile
+// templates/init/node/docker-compose.yml
+// templates/init/python/.dockerignore
+// templates/init/python/Dockerfile
+// templates/init/python/docker-compose.yml
 // templates/init/rails/.dockerignore
 // templates/init/rails/Dockerfile
 // templates/init/rails/docker-compose.yml
@@ -9,6 +27,9 @@
 // templates/init/sinatra/.dockerignore
 // templates/init/sinatra/Dockerfile
 // templates/init/sinatra/docker-compose.yml
+// templates/init/static/.dockerignore
+// templates/init/static/Dockerfile
+// templates/init/static/docker-compose.yml
 // templates/init/unknown/.dockerignore
 // templates/init/unknown/Dockerfile
 // templates/init/unknown/docker-compose.yml
@@ -80,6 +101,366 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
+var _initGoDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd3\xd7\x4b\xce\xcf\x2b\xcb\xaf\xe0\xd2\xd7\x4b\xcd\x2b\x03\x92\xe9\x99\x25\x5c\x00\x8e\xc2\x4f\x1a\x15\x00\x00\x00")
+
+func initGoDockerignoreBytes() ([]byte, error) {
+	return bindataRead(
+		_initGoDockerignore,
+		"init/go/.dockerignore",
+	)
+}
+
+func initGoDockerignore() (*asset, error) {
+	bytes, err := initGoDockerignoreBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/go/.dockerignore", size: 21, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initGoDockerfile = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7d\x8e\xb1\x4e\xc3\x30\x14\x45\xf7\xf7\x15\x57\x45\xea\x56\x3b\x16\x1b\x15\x53\x53\x10\x82\xd4\x95\x45\x41\x55\xd5\x21\x69\x1c\xc7\x92\x6b\x47\x75\xa2\xfc\x3e\x0e\x84\x81\x85\xf5\x9d\x7b\xcf\xbb\x4f\x4a\x16\x30\xc1\x95\xde\x3c\x08\x26\x04\xd1\x76\xf7\x81\xbd\x54\xef\xb8\xcf\xb2\x8c\xe8\x53\xaa\xd7\xfc\x45\x81\x9b\xc0\xe3\xed\xc2\xcb\xae\x23\xba\x43\x35\x58\x57\x63\xb4\x7d\x8b\x6b\xa8\x07\xa7\x23\xc6\x56\x7b\xf4\xad\xbe\x69\xd8\x88\x32\x59\x59\x42\x28\xfd\x9c\x33\xa1\xd6\x1d\xc2\x94\x18\x6d\xd4\xb4\x91\xfb\x23\xd8\x1f\xb1\x3a\xec\x60\x1b\x9c\xb0\x6a\x7e\xeb\xe7\xf5\xe4\xf4\x78\x96\x42\x88\x42\xe6\x87\xb7\xed\x63\xf0\x89\xce\x13\x56\xe1\xdb\x50\x59\x3f\x19\xc0\xd6\xd0\x2e\xea\x89\x1b\xdd\xc3\xa4\xc7\x43\xc5\x2e\xe1\xca\xfb\x10\x5c\xe4\x3f\x23\x96\xcb\x79\xcd\x3f\x9a\xc6\x12\x6d\x8a\x1c\xa7\x45\x3a\x2c\xce\xf4\x05\xf7\x26\xf2\x83\x2a\x01\x00\x00")
+
+func initGoDockerfileBytes() ([]byte, error) {
+	return bindataRead(
+		_initGoDockerfile,
+		"init/go/Dockerfile",
+	)
+}
+
+func initGoDockerfile() (*asset, error) {
+	bytes, err := initGoDockerfileBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/go/Dockerfile", size: 298, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initGoDockerComposeYml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2b\x4f\x4d\xb2\xe2\x52\x50\x48\x2a\xcd\xcc\x49\xb1\x52\xd0\x03\x32\x73\x12\x93\x52\x73\x8a\x41\x82\x0a\x0a\xba\x0a\xc9\xf9\x79\x65\xf9\x15\x7a\x05\xf9\x45\x25\x7a\x26\x26\xc6\x7a\x05\x45\xf9\x25\xf9\xc9\xf9\x39\xb6\x25\x39\xc5\x40\x25\x20\x71\xb8\x5a\x0b\x03\x2b\x63\x03\x03\x03\x28\x0f\xa8\x1a\xc2\x05\x00\xdc\x2d\xde\xce\x63\x00\x00\x00")
+
+func initGoDockerComposeYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_initGoDockerComposeYml,
+		"init/go/docker-compose.yml",
+	)
+}
+
+func initGoDockerComposeYml() (*asset, error) {
+	bytes, err := initGoDockerComposeYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/go/docker-compose.yml", size: 99, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initGradleDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd3\xd7\x4b\xce\xcf\x2b\xcb\xaf\xe0\xd2\xd7\x4b\xcd\x2b\x03\x92\xe9\x99\x25\x20\xb2\x28\x31\x25\x27\x95\x4b\x3f\xa9\x34\x33\x27\x85\x0b\x00\xc7\x83\xd9\x5e\x25\x00\x00\x00")
+
+func initGradleDockerignoreBytes() ([]byte, error) {
+	return bindataRead(
+		_initGradleDockerignore,
+		"init/gradle/.dockerignore",
+	)
+}
+
+func initGradleDockerignore() (*asset, error) {
+	bytes, err := initGradleDockerignoreBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/gradle/.dockerignore", size: 37, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initGradleDockerfile = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2d\x8c\x4d\x0b\x82\x40\x18\x84\xef\xef\xaf\x18\xec\x16\xad\x16\x74\x88\xae\x59\x10\x61\xc6\x86\x44\x44\x87\x57\x77\xf1\x33\x77\xd1\x2d\xea\xdf\x67\xd8\x65\x60\x1e\xe6\x99\x9d\x8c\x23\xe4\x1d\xab\x46\xaf\x97\xfe\x62\x2e\x2a\x55\xaf\x88\x92\xf3\x56\xa2\x33\xc6\xd1\x25\x96\x87\x70\x2f\x11\xb0\xb5\x44\x13\x64\xc6\x7e\xe0\x0a\x8d\xa1\x83\x5b\x05\xcb\x59\xcd\xb9\x46\xe9\x68\x13\x9f\xae\xf0\xc7\xa9\x4c\x8e\xff\x5f\xa4\xcf\xb2\x51\x10\x6f\x38\xdd\x3b\x08\xd1\x1a\xa1\x58\x3f\x4c\x4b\xb4\x89\x42\xdc\xbc\xbe\xf0\x66\xf0\x44\xf6\xcb\x8a\x5f\x0c\x51\x71\x37\x6a\x41\x53\xa6\x7d\x30\xf5\x07\xe0\xdd\xe9\x0b\xb2\x49\xea\x7f\xae\x00\x00\x00")
+
+func initGradleDockerfileBytes() ([]byte, error) {
+	return bindataRead(
+		_initGradleDockerfile,
+		"init/gradle/Dockerfile",
+	)
+}
+
+func initGradleDockerfile() (*asset, error) {
+	bytes, err := initGradleDockerfileBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/gradle/Dockerfile", size: 174, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initGradleDockerComposeYml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2b\x4f\x4d\xb2\xe2\x52\x50\x48\x2a\xcd\xcc\x49\xb1\x52\xd0\x03\x32\x73\x12\x93\x52\x73\x8a\x41\x82\x0a\x0a\xba\x0a\xc9\xf9\x79\x65\xf9\x15\x7a\x05\xf9\x45\x25\x7a\x26\x26\xc6\x7a\x05\x45\xf9\x25\xf9\xc9\xf9\x39\xb6\x25\x39\xc5\x40\x25\x20\x71\xb8\x5a\x0b\x03\x2b\x0b\x03\x0b\x03\x28\x0f\xa8\x1a\xc2\x05\x00\x01\x78\x3e\x92\x63\x00\x00\x00")
+
+func initGradleDockerComposeYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_initGradleDockerComposeYml,
+		"init/gradle/docker-compose.yml",
+	)
+}
+
+func initGradleDockerComposeYml() (*asset, error) {
+	bytes, err := initGradleDockerComposeYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/gradle/docker-compose.yml", size: 99, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initMavenDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd3\xd7\x4b\xce\xcf\x2b\xcb\xaf\xe0\xd2\xd7\x4b\xcd\x2b\x03\x92\xe9\x99\x25\x5c\xfa\x25\x89\x45\xe9\xa9\x25\x5c\x00\xb6\x89\xef\x97\x1d\x00\x00\x00")
+
+func initMavenDockerignoreBytes() ([]byte, error) {
+	return bindataRead(
+		_initMavenDockerignore,
+		"init/maven/.dockerignore",
+	)
+}
+
+func initMavenDockerignore() (*asset, error) {
+	bytes, err := initMavenDockerignoreBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/maven/.dockerignore", size: 29, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initMavenDockerfile = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x8e\x4d\x4b\xc4\x30\x10\x86\xef\xf9\x15\x2f\xf5\x26\xa6\x3d\x78\x59\xf6\xe8\x16\x41\x64\xed\x52\x14\x91\xc5\xc3\x90\x4c\xda\xf4\x23\x09\x4d\x28\xf6\xdf\xdb\xdd\x75\xd5\xcb\x30\x2f\xbc\xf3\x3c\xf3\x58\x57\x7b\x8c\x34\xb3\xdb\xde\xcb\x4e\xf7\x72\x23\xc4\x7b\x55\x3f\x97\x4f\x35\x0a\x0a\x41\x88\x1b\x28\x1f\x16\x78\x37\x2c\x48\x2d\xc3\xd8\x81\x23\x1c\xb3\x66\x0d\xe3\x27\x18\x4e\xaa\xb5\xae\x81\xe6\xc0\x4e\xb3\x53\x96\xa3\xd8\x55\x87\x0f\x04\x3f\xe6\x5f\xe3\x70\x26\x15\x3f\x41\xd4\x6f\x2f\x18\x67\x07\xf9\xf0\x77\xb1\x6c\x1b\x2f\xbd\x31\x83\x75\xfc\xab\x3c\xd9\x26\x8e\x09\xde\x9c\xf7\x15\x02\x72\x1a\x81\x54\x4f\x0d\xc3\xa6\x8b\x25\xbf\x7c\xfa\x8f\x7b\x6d\xc8\x32\xf6\x36\xbc\xae\x8c\x28\xc4\x6e\x5f\xe2\x98\xc5\x36\xbb\x43\x26\xd5\x69\x76\x34\x13\x64\x47\x13\x12\x4d\x0d\xa7\xe2\x36\x5f\x43\xf6\x29\xbe\x01\x53\x11\xd2\xd4\x16\x01\x00\x00")
+
+func initMavenDockerfileBytes() ([]byte, error) {
+	return bindataRead(
+		_initMavenDockerfile,
+		"init/maven/Dockerfile",
+	)
+}
+
+func initMavenDockerfile() (*asset, error) {
+	bytes, err := initMavenDockerfileBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/maven/Dockerfile", size: 278, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initMavenDockerComposeYml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2b\x4f\x4d\xb2\xe2\x52\x50\x48\x2a\xcd\xcc\x49\xb1\x52\xd0\x03\x32\x73\x12\x93\x52\x73\x8a\x41\x82\x0a\x0a\xba\x0a\xc9\xf9\x79\x65\xf9\x15\x7a\x05\xf9\x45\x25\x7a\x26\x26\xc6\x7a\x05\x45\xf9\x25\xf9\xc9\xf9\x39\xb6\x25\x39\xc5\x40\x25\x20\x71\xb8\x5a\x0b\x03\x2b\x0b\x03\x0b\x03\x28\x0f\xa8\x1a\xc2\x05\x00\x01\x78\x3e\x92\x63\x00\x00\x00")
+
+func initMavenDockerComposeYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_initMavenDockerComposeYml,
+		"init/maven/docker-compose.yml",
+	)
+}
+
+func initMavenDockerComposeYml() (*asset, error) {
+	bytes, err := initMavenDockerComposeYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/maven/docker-compose.yml", size: 99, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initMeteorDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd3\xd7\x4b\xce\xcf\x2b\xcb\xaf\xe0\xd2\xd7\x4b\xcd\x2b\x03\x92\xe9\x99\x25\x40\x32\x37\xb5\x24\x35\xbf\x48\x3f\x27\x3f\x39\x31\x87\x4b\x3f\x2f\x3f\x25\x35\x3e\x37\x3f\xa5\x34\x27\xb5\x98\x0b\x00\x2b\xaf\xf1\xf7\x32\x00\x00\x00")
+
+func initMeteorDockerignoreBytes() ([]byte, error) {
+	return bindataRead(
+		_initMeteorDockerignore,
+		"init/meteor/.dockerignore",
+	)
+}
+
+func initMeteorDockerignore() (*asset, error) {
+	bytes, err := initMeteorDockerignoreBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/meteor/.dockerignore", size: 50, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initMeteorDockerfile = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x73\x0b\xf2\xf7\x55\xc8\x4d\x2d\x49\xcd\x2f\xca\x48\x4c\xce\x2e\xd6\x87\xb0\x53\xac\xf2\xf3\x92\x4a\x33\x73\x52\xb8\x00\x3e\xb9\x47\xdb\x21\x00\x00\x00")
+
+func initMeteorDockerfileBytes() ([]byte, error) {
+	return bindataRead(
+		_initMeteorDockerfile,
+		"init/meteor/Dockerfile",
+	)
+}
+
+func initMeteorDockerfile() (*asset, error) {
+	bytes, err := initMeteorDockerfileBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/meteor/Dockerfile", size: 33, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initMeteorDockerComposeYml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2b\x4f\x4d\xb2\xe2\x52\x50\x48\x2a\xcd\xcc\x49\xb1\x52\xd0\x03\x32\x73\x12\x93\x52\x73\x8a\x41\x82\x0a\x0a\xba\x0a\xc9\xf9\x79\x65\xf9\x15\x7a\x05\xf9\x45\x25\x7a\x26\x26\xc6\x7a\x05\x45\xf9\x25\xf9\xc9\xf9\x39\xb6\x25\x39\xc5\x40\x25\x20\x71\xb8\x5a\x0b\x03\x2b\x0b\x03\x28\x1b\xa8\x16\xc4\x01\x00\x19\x82\x1d\x31\x5f\x00\x00\x00")
+
+func initMeteorDockerComposeYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_initMeteorDockerComposeYml,
+		"init/meteor/docker-compose.yml",
+	)
+}
+
+func initMeteorDockerComposeYml() (*asset, error) {
+	bytes, err := initMeteorDockerComposeYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/meteor/docker-compose.yml", size: 95, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initNodeDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd3\xd7\x4b\xce\xcf\x2b\xcb\xaf\xe0\xd2\xd7\x4b\xcd\x2b\x03\x92\xe9\x99\x25\x5c\xfa\x79\xf9\x29\xa9\xf1\xb9\xf9\x29\xa5\x39\xa9\xc5\x40\x5e\x41\xae\x6e\x4a\x6a\x52\x69\xba\x5e\x4e\x7e\x3a\x97\x7e\x65\x62\x51\x9e\x6e\x6a\x51\x51\x7e\x11\x98\x0f\x00\x7c\x7f\x3c\x7e\x42\x00\x00\x00")
+
+func initNodeDockerignoreBytes() ([]byte, error) {
+	return bindataRead(
+		_initNodeDockerignore,
+		"init/node/.dockerignore",
+	)
+}
+
+func initNodeDockerignore() (*asset, error) {
+	bytes, err := initNodeDockerignoreBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/node/.dockerignore", size: 66, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initNodeDockerfile = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4d\x90\xcd\x4e\xc3\x30\x10\x84\xef\x7e\x8a\x51\xb8\x55\x24\x8d\xc4\x05\x91\x63\x0b\x12\x42\x6d\xaa\x88\x1f\xa1\xaa\x07\x2b\x5e\xb7\xa6\x66\x6d\xc5\x41\x28\x3c\x3d\xb6\x69\x81\x9b\xbd\x3b\xb3\xfb\xcd\xde\x75\xed\x0a\xec\x14\xdd\x5c\x0b\x71\xbb\x7e\xc6\xa6\xed\x1e\x71\x55\xd7\xb5\x10\x2f\x6d\xf7\xb0\xbc\xef\x30\x97\xde\x0b\x71\x81\xde\xf9\x09\x8e\xed\x84\xf1\x40\xd0\xc6\x52\x00\x13\x29\x52\xd0\x6e\x80\xe1\x30\x4a\x6b\x0d\xef\xa1\xc8\x13\x2b\xe2\xde\x50\x88\xc6\x49\x0e\x0c\x13\xf0\x11\xa2\xf4\xf3\x40\x0c\x99\x6b\x95\x75\xfd\x31\x35\xfc\x40\x81\x78\x14\x8b\x76\xf3\x0a\x2f\xfb\xa3\xdc\x53\xf5\x16\x1c\xff\xc9\x66\xe7\x7a\x99\x7e\xb9\x39\xcb\x64\x73\xd1\x3d\xad\x61\x34\xb6\x28\xf5\xbf\xb1\xbb\x26\x61\xf2\x69\xf9\x0f\x1b\xca\x52\x0f\xee\x8b\x38\x0f\x49\x09\x1a\x90\x0d\x04\xf6\xef\x67\x4d\x13\x93\xfd\xa6\x4d\x41\x23\xdb\x08\xa7\xf3\x3b\x5d\x22\x43\x56\xa7\xab\x2c\x56\x4b\x6c\x8b\x68\x2f\x2e\x51\x44\xff\x30\x16\x3b\xf1\x0d\xa1\x7d\xaa\x12\x55\x01\x00\x00")
+
+func initNodeDockerfileBytes() ([]byte, error) {
+	return bindataRead(
+		_initNodeDockerfile,
+		"init/node/Dockerfile",
+	)
+}
+
+func initNodeDockerfile() (*asset, error) {
+	bytes, err := initNodeDockerfileBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/node/Dockerfile", size: 341, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initNodeDockerComposeYml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2b\x4f\x4d\xb2\xe2\x52\x50\x48\x2a\xcd\xcc\x49\xb1\x52\xd0\x03\x32\x73\x12\x93\x52\x73\x8a\x41\x82\x0a\x0a\xba\x0a\xc9\xf9\x79\x65\xf9\x15\x7a\x05\xf9\x45\x25\x7a\x26\x26\xc6\x7a\x05\x45\xf9\x25\xf9\xc9\xf9\x39\xb6\x25\x39\xc5\x40\x25\x20\x71\xb8\x5a\x0b\x03\x2b\x63\x03\x03\x03\x28\x0f\xa8\x1a\xc2\x05\x00\xdc\x2d\xde\xce\x63\x00\x00\x00")
+
+func initNodeDockerComposeYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_initNodeDockerComposeYml,
+		"init/node/docker-compose.yml",
+	)
+}
+
+func initNodeDockerComposeYml() (*asset, error) {
+	bytes, err := initNodeDockerComposeYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/node/docker-compose.yml", size: 99, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initPythonDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd3\xd7\x4b\xce\xcf\x2b\xcb\xaf\xe0\xd2\xd7\x4b\xcd\x2b\x03\x92\xe9\x99\x25\x40\xb2\x0c\xc4\xd1\xd2\x2b\xa8\x4c\xe6\x8a\x8f\x07\x92\x89\xc9\x19\xa9\xf1\xf1\x5c\x00\x95\xb5\x6d\xfe\x2e\x00\x00\x00")
+
+func initPythonDockerignoreBytes() ([]byte, error) {
+	return bindataRead(
+		_initPythonDockerignore,
+		"init/python/.dockerignore",
+	)
+}
+
+func initPythonDockerignore() (*asset, error) {
+	bytes, err := initPythonDockerignoreBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/python/.dockerignore", size: 46, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initPythonDockerfile = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x65\x90\xcd\x4a\xc3\x40\x14\x85\xf7\xf3\x14\x07\x84\x2e\x0a\x49\x23\x05\x17\x66\xa7\x4d\xa8\x88\x49\x18\x1a\xa5\x88\x8b\xd0\xdc\x69\x06\xd2\xc9\x98\x99\xaa\x79\x7b\x67\x52\x13\x28\xee\xee\xff\xf9\xce\x4d\x79\xfe\x02\x3d\xd8\xa6\x53\xf7\xeb\xf0\x8e\xb1\x24\x7b\x45\x91\xf3\x1d\xd6\x51\x14\x5d\xb2\xfd\x6e\x9b\x67\x65\xf6\x50\xa6\x69\xc2\x93\x0d\x6e\x19\x7b\xcb\xf9\xf3\xe6\x89\x63\x55\x69\xcd\xd8\x0d\x0e\x9d\x1e\xd0\xa9\x76\x80\x6d\x08\x42\xb6\x64\xa0\x88\x6a\xaa\x21\xba\x1e\x52\x19\x5b\xb5\xad\x54\x47\xd4\xa4\x49\xd5\xa4\x0e\x92\x8c\x5b\xd4\xd2\xa5\x5f\x90\x06\x67\xe3\x86\xbf\x1b\x52\xa8\x50\x48\xed\x6f\xf8\xb2\xee\xc9\x90\xb2\xec\x31\x2f\xf6\xe8\xe9\xf3\x2c\x7b\x3a\xb9\x82\x09\xed\x8f\x5d\x4e\x93\xcb\x91\x64\xc5\x78\x99\x41\x0a\xbc\x23\x10\xf3\x91\x8f\xd8\x43\x29\x2f\x35\x81\x4c\xb2\x8b\xc5\x0c\xf0\xd7\x08\x02\x33\x18\x4b\xa7\x18\xd4\x1a\xba\xda\x09\xfa\x7f\xfa\xb1\xb3\x3a\xdb\xf7\xce\x1d\xac\x45\x27\xc6\xd8\xbf\x66\xa4\x0e\x2f\x6f\xfa\x05\xc5\x6d\x53\x75\x6b\x01\x00\x00")
+
+func initPythonDockerfileBytes() ([]byte, error) {
+	return bindataRead(
+		_initPythonDockerfile,
+		"init/python/Dockerfile",
+	)
+}
+
+func initPythonDockerfile() (*asset, error) {
+	bytes, err := initPythonDockerfileBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/python/Dockerfile", size: 363, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initPythonDockerComposeYml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3d\x8c\x4b\x0a\xc3\x30\x0c\x44\xf7\x3d\x85\xc8\x3e\xc6\x90\x2c\x8a\xa1\x87\xb1\x1d\x41\x4c\xe5\xc8\xd8\x72\xd3\xdc\x3e\x0a\xfd\xec\xf4\x34\x6f\x66\xc7\xe0\x6e\x00\xa1\x27\x5a\x1c\x18\x3d\x23\xe7\xec\x37\x05\x8c\x2b\xc3\x80\x4b\x12\x58\x38\x3e\xb1\x8e\x1a\x15\x6e\x68\x8e\x4c\xb0\x27\x59\xe1\xe0\x5e\xa1\x89\xaf\xd2\xcb\xaf\x38\xe8\x06\xf9\x80\xd4\xae\x61\x80\x51\x83\xed\xc5\x6f\x53\xb8\x8a\x99\xe7\xc9\x94\xca\xc2\x91\xe9\x21\xd4\x54\xb9\xfe\x7f\xf7\x6e\xdd\x64\xad\xfd\x92\xda\x1f\x3c\x01\xc6\x0d\x47\x32\xa7\x00\x00\x00")
+
+func initPythonDockerComposeYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_initPythonDockerComposeYml,
+		"init/python/docker-compose.yml",
+	)
+}
+
+func initPythonDockerComposeYml() (*asset, error) {
+	bytes, err := initPythonDockerComposeYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/python/docker-compose.yml", size: 167, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _initRailsDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd2\xd7\x4b\x2a\xcd\x4b\xc9\x49\xe5\xd2\xd7\x4b\xce\xcf\x2b\xcb\xaf\x00\x32\x52\xf3\xca\x80\x64\x7a\x66\x09\x97\x7e\x4a\x92\xbe\x96\x5e\x71\x61\x4e\x66\x49\xaa\x31\x2a\x4f\x37\x2b\xbf\xb4\x28\x2f\x31\x87\x4b\x3f\x27\x3f\x5d\x5f\x8b\x4b\xbf\x24\xb7\x80\x0b\x10\x00\x00\xff\xff\xa0\x04\x95\x56\x4e\x00\x00\x00")
 
 func initRailsDockerignoreBytes() ([]byte, error) {
@@ -260,6 +641,66 @@ func initSinatraDockerComposeYml() (*asset, error) {
 	return a, nil
 }
 
+var _initStaticDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd3\xd7\x4b\xce\xcf\x2b\xcb\xaf\xe0\xd2\xd7\x4b\xc9\x4f\xce\x4e\x2d\xca\x4c\xcf\xcb\x2f\x4a\x05\x72\x53\xf3\xca\x80\x64\x7a\x66\x09\x97\xbe\x0b\x58\x26\x2d\x33\x07\x28\x0e\x51\xa5\x9b\x9c\x9f\x5b\x90\x5f\x9c\xaa\x57\x99\x9b\xc3\x05\x00\x4b\xa4\x8d\x1e\x44\x00\x00\x00")
+
+func initStaticDockerignoreBytes() ([]byte, error) {
+	return bindataRead(
+		_initStaticDockerignore,
+		"init/static/.dockerignore",
+	)
+}
+
+func initStaticDockerignore() (*asset, error) {
+	bytes, err := initStaticDockerignoreBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/static/.dockerignore", size: 68, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initStaticDockerfile = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x73\x0b\xf2\xf7\x55\xc8\x4b\xcf\xcc\xab\xb0\x4a\xcc\x29\xc8\xcc\x4b\xe5\xe2\x72\xf6\x0f\x88\x54\xd0\x53\xd0\x2f\x2d\x2e\xd2\x2f\xce\x48\x2c\x4a\xd5\x07\xcb\xeb\x67\x94\xe4\xe6\x70\x01\x00\x5c\x86\x45\x49\x30\x00\x00\x00")
+
+func initStaticDockerfileBytes() ([]byte, error) {
+	return bindataRead(
+		_initStaticDockerfile,
+		"init/static/Dockerfile",
+	)
+}
+
+func initStaticDockerfile() (*asset, error) {
+	bytes, err := initStaticDockerfileBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/static/Dockerfile", size: 48, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _initStaticDockerComposeYml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2b\x4f\x4d\xb2\xe2\x52\x50\x48\x2a\xcd\xcc\x49\xb1\x52\xd0\x03\x32\x73\x12\x93\x52\x73\x8a\x41\x82\x0a\x0a\xba\x0a\xc9\xf9\x79\x65\xf9\x15\x7a\x05\xf9\x45\x25\x7a\x26\x26\xc6\x7a\x05\x45\xf9\x25\xf9\xc9\xf9\x39\xb6\x25\x39\xc5\x40\x25\x20\x71\xb8\x5a\x0b\x03\x2b\x0b\x03\x28\x1b\xa8\x16\xc4\x01\x00\x19\x82\x1d\x31\x5f\x00\x00\x00")
+
+func initStaticDockerComposeYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_initStaticDockerComposeYml,
+		"init/static/docker-compose.yml",
+	)
+}
+
+func initStaticDockerComposeYml() (*asset, error) {
+	bytes, err := initStaticDockerComposeYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "init/static/docker-compose.yml", size: 95, mode: os.FileMode(420), modTime: time.Unix(1792425516, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _initUnknownDockerignore = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd2\x4b\xcd\x2b\xe3\xd2\x4b\xcf\x2c\xe1\x02\x04\x00\x00\xff\xff\x9c\x10\x28\x7b\x0a\x00\x00\x00")
 
 func initUnknownDockerignoreBytes() ([]byte, error) {
@@ -392,6 +833,24 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"init/go/.dockerignore": initGoDockerignore,
+	"init/go/Dockerfile": initGoDockerfile,
+	"init/go/docker-compose.yml": initGoDockerComposeYml,
+	"init/gradle/.dockerignore": initGradleDockerignore,
+	"init/gradle/Dockerfile": initGradleDockerfile,
+	"init/gradle/docker-compose.yml": initGradleDockerComposeYml,
+	"init/maven/.dockerignore": initMavenDockerignore,
+	"init/maven/Dockerfile": initMavenDockerfile,
+	"init/maven/docker-compose.yml": initMavenDockerComposeYml,
+	"init/meteor/.dockerignore": initMeteorDockerignore,
+	"init/meteor/Dockerfile": initMeteorDockerfile,
+	"init/meteor/docker-compose.yml": initMeteorDockerComposeYml,
+	"init/node/.dockerignore": initNodeDockerignore,
+	"init/node/Dockerfile": initNodeDockerfile,
+	"init/node/docker-compose.yml": initNodeDockerComposeYml,
+	"init/python/.dockerignore": initPythonDockerignore,
+	"init/python/Dockerfile": initPythonDockerfile,
+	"init/python/docker-compose.yml": initPythonDockerComposeYml,
 	"init/rails/.dockerignore": initRailsDockerignore,
 	"init/rails/Dockerfile": initRailsDockerfile,
 	"init/rails/docker-compose.yml": initRailsDockerComposeYml,
@@ -401,6 +860,9 @@ var _bindata = map[string]func() (*asset, error){
 	"init/sinatra/.dockerignore": initSinatraDockerignore,
 	"init/sinatra/Dockerfile": initSinatraDockerfile,
 	"init/sinatra/docker-compose.yml": initSinatraDockerComposeYml,
+	"init/static/.dockerignore": initStaticDockerignore,
+	"init/static/Dockerfile": initStaticDockerfile,
+	"init/static/docker-compose.yml": initStaticDockerComposeYml,
 	"init/unknown/.dockerignore": initUnknownDockerignore,
 	"init/unknown/Dockerfile": initUnknownDockerfile,
 	"init/unknown/docker-compose.yml": initUnknownDockerComposeYml,
@@ -448,6 +910,36 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"init": &bintree{nil, map[string]*bintree{
+		"go": &bintree{nil, map[string]*bintree{
+			".dockerignore": &bintree{initGoDockerignore, map[string]*bintree{}},
+			"Dockerfile": &bintree{initGoDockerfile, map[string]*bintree{}},
+			"docker-compose.yml": &bintree{initGoDockerComposeYml, map[string]*bintree{}},
+		}},
+		"gradle": &bintree{nil, map[string]*bintree{
+			".dockerignore": &bintree{initGradleDockerignore, map[string]*bintree{}},
+			"Dockerfile": &bintree{initGradleDockerfile, map[string]*bintree{}},
+			"docker-compose.yml": &bintree{initGradleDockerComposeYml, map[string]*bintree{}},
+		}},
+		"maven": &bintree{nil, map[string]*bintree{
+			".dockerignore": &bintree{initMavenDockerignore, map[string]*bintree{}},
+			"Dockerfile": &bintree{initMavenDockerfile, map[string]*bintree{}},
+			"docker-compose.yml": &bintree{initMavenDockerComposeYml, map[string]*bintree{}},
+		}},
+		"meteor": &bintree{nil, map[string]*bintree{
+			".dockerignore": &bintree{initMeteorDockerignore, map[string]*bintree{}},
+			"Dockerfile": &bintree{initMeteorDockerfile, map[string]*bintree{}},
+			"docker-compose.yml": &bintree{initMeteorDockerComposeYml, map[string]*bintree{}},
+		}},
+		"node": &bintree{nil, map[string]*bintree{
+			".dockerignore": &bintree{initNodeDockerignore, map[string]*bintree{}},
+			"Dockerfile": &bintree{initNodeDockerfile, map[string]*bintree{}},
+			"docker-compose.yml": &bintree{initNodeDockerComposeYml, map[string]*bintree{}},
+		}},
+		"python": &bintree{nil, map[string]*bintree{
+			".dockerignore": &bintree{initPythonDockerignore, map[string]*bintree{}},
+			"Dockerfile": &bintree{initPythonDockerfile, map[string]*bintree{}},
+			"docker-compose.yml": &bintree{initPythonDockerComposeYml, map[string]*bintree{}},
+		}},
 		"rails": &bintree{nil, map[string]*bintree{
 			".dockerignore": &bintree{initRailsDockerignore, map[string]*bintree{}},
 			"Dockerfile": &bintree{initRailsDockerfile, map[string]*bintree{}},
@@ -463,6 +955,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"Dockerfile": &bintree{initSinatraDockerfile, map[string]*bintree{}},
 			"docker-compose.yml": &bintree{initSinatraDockerComposeYml, map[string]*bintree{}},
 		}},
+		"static": &bintree{nil, map[string]*bintree{
+			".dockerignore": &bintree{initStaticDockerignore, map[string]*bintree{}},
+			"Dockerfile": &bintree{initStaticDockerfile, map[string]*bintree{}},
+			"docker-compose.yml": &bintree{initStaticDockerComposeYml, map[string]*bintree{}},
+		}},
 		"unknown": &bintree{nil, map[string]*bintree{
 			".dockerignore": &bintree{initUnknownDockerignore, map[string]*bintree{}},
 			"Dockerfile": &bintree{initUnknownDockerfile, map[string]*bintree{}},